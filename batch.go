@@ -0,0 +1,224 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BatchError reports the symbols that GetMany could not fetch.
+type BatchError struct {
+	// Errors maps each failed symbol to the reason it failed.
+	Errors map[string]error
+}
+
+// Error implements the error interface.
+func (e *BatchError) Error() string {
+	symbols := make([]string, 0, len(e.Errors))
+	for symbol := range e.Errors {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "tradingview: %d symbol(s) failed", len(symbols))
+	for i, symbol := range symbols {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		fmt.Fprintf(&b, "%s: %v", symbol, e.Errors[symbol])
+	}
+	return b.String()
+}
+
+// Unwrap returns the per-symbol errors so errors.Is and errors.As can match
+// any of them.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// scanRequest is the body of a market scan request. The fields after
+// Columns are only used by Screener.
+type scanRequest struct {
+	Symbols scanSymbols  `json:"symbols"`
+	Columns []string     `json:"columns"`
+	Filter  []scanFilter `json:"filter,omitempty"`
+	Options *scanOptions `json:"options,omitempty"`
+	Markets []string     `json:"markets,omitempty"`
+	Sort    *scanSort    `json:"sort,omitempty"`
+	Range   []int        `json:"range,omitempty"`
+}
+
+// scanSymbols selects the symbols of a market scan request. Query is only
+// used by Screener.
+type scanSymbols struct {
+	Tickers []string   `json:"tickers"`
+	Query   *scanQuery `json:"query,omitempty"`
+}
+
+type scanQuery struct {
+	Types []string `json:"types"`
+}
+
+type scanOptions struct {
+	Lang string `json:"lang"`
+}
+
+// scanResponse is the body of a market scan response.
+type scanResponse struct {
+	TotalCount int       `json:"totalCount"`
+	Data       []scanRow `json:"data"`
+}
+
+// scanRow holds one symbol's values in the order of the requested columns.
+type scanRow struct {
+	Symbol string            `json:"s"`
+	Values []json.RawMessage `json:"d"`
+}
+
+// GetMany fetches recommendations and raw indicator values for several
+// symbols at interval with a single scan request.
+//
+// Symbols are normalized as by ParseSymbol. The returned map is keyed by
+// normalized symbol and contains every symbol that was fetched
+// successfully. If some symbols are invalid or missing from the response,
+// GetMany also returns a *BatchError describing each of them, keyed by the
+// symbol as given for invalid symbols and by normalized symbol otherwise;
+// other errors mean that the whole request failed and the map is nil.
+// In strict mode, symbols with missing fields are included in the map and
+// also reported in the *BatchError.
+func (c *Client) GetMany(ctx context.Context, symbols []string, interval Interval) (map[string]*TradingView, error) {
+	dataInterval, err := c.intervalSuffix(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]*TradingView, len(symbols))
+	failed := make(map[string]error)
+
+	tickers := make([]string, 0, len(symbols))
+	requested := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		normalized, err := normalizeSymbol(symbol)
+		if err != nil {
+			failed[symbol] = err
+			continue
+		}
+		if requested[normalized] {
+			continue
+		}
+		requested[normalized] = true
+		tickers = append(tickers, normalized)
+	}
+
+	if len(tickers) > 0 {
+		columns := c.columns(dataInterval)
+
+		res, err := c.scan(ctx, "global", interval, scanRequest{
+			Symbols: scanSymbols{Tickers: tickers},
+			Columns: columns,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fetchedAt := time.Now()
+
+		for _, row := range res.Data {
+			if !requested[row.Symbol] {
+				continue
+			}
+			responseMap, strs, err := decodeRow(columns, row.Values)
+			if err != nil {
+				failed[row.Symbol] = err
+				continue
+			}
+
+			ta := &TradingView{}
+			ta.populate(responseMap, dataInterval, c.rules())
+			ta.populateExtra(c.extra(), responseMap, strs, dataInterval)
+			ta.setSource(row.Symbol, interval, fetchedAt)
+			results[row.Symbol] = ta
+			if err := c.checkMissing(row.Symbol, interval, ta); err != nil {
+				failed[row.Symbol] = err
+			}
+		}
+
+		for _, symbol := range tickers {
+			if _, ok := results[symbol]; !ok && failed[symbol] == nil {
+				failed[symbol] = ErrSymbolNotFound
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return results, &BatchError{Errors: failed}
+	}
+	return results, nil
+}
+
+// scan posts body to the scan endpoint of market and decodes the response.
+// Interval is reported in errors.
+func (c *Client) scan(ctx context.Context, market string, interval Interval, body scanRequest) (*scanResponse, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.scanURL(market), bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	jsonData, err := c.do(ctx, req, requestInfo{interval: interval})
+	if err != nil {
+		return nil, err
+	}
+
+	var res scanResponse
+	if err := json.Unmarshal(jsonData, &res); err != nil {
+		return nil, newDecodeError(jsonData, err)
+	}
+	return &res, nil
+}
+
+// decodeRow maps the numeric and string values of a scan row back to their
+// column names. Null values are left out of the returned maps.
+func decodeRow(columns []string, values []json.RawMessage) (map[string]float64, map[string]string, error) {
+	if len(values) != len(columns) {
+		return nil, nil, &DecodeError{Offset: -1, Err: fmt.Errorf("got %d values for %d columns", len(values), len(columns))}
+	}
+
+	responseMap := make(map[string]float64, len(columns))
+	var strs map[string]string
+	for i, raw := range values {
+		if err := decodeValue(columns[i], raw, responseMap, &strs); err != nil {
+			return nil, nil, err
+		}
+	}
+	return responseMap, strs, nil
+}
+
+func (c *Client) scanURL(market string) string {
+	base := defaultScanBaseURL
+	if c != nil && c.ScanBaseURL != "" {
+		base = c.ScanBaseURL
+	}
+	return strings.TrimSuffix(base, "/") + "/" + market + "/scan"
+}
@@ -0,0 +1,205 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// scanHandler serves scan requests from rows keyed by ticker and column.
+func scanHandler(t *testing.T, rows map[string]map[string]any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("unexpected content type %q", got)
+		}
+
+		var req scanRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		res := struct {
+			TotalCount int   `json:"totalCount"`
+			Data       []any `json:"data"`
+		}{}
+		for _, ticker := range req.Symbols.Tickers {
+			row, ok := rows[ticker]
+			if !ok {
+				continue
+			}
+			values := make([]any, len(req.Columns))
+			for i, column := range req.Columns {
+				values[i] = row[column]
+			}
+			res.Data = append(res.Data, map[string]any{"s": ticker, "d": values})
+		}
+		res.TotalCount = len(res.Data)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+func TestClient_GetMany(t *testing.T) {
+	var path string
+	handler := scanHandler(t, map[string]map[string]any{
+		"BINANCE:BTCUSDT": {"Recommend.All|60": 0.6, "close|60": 70998.71},
+		"BINANCE:ETHUSDT": {"Recommend.All|60": -0.3, "close|60": 3500.5},
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+	}
+
+	results, err := client.GetMany(context.Background(), []string{"BINANCE:BTCUSDT", "BINANCE:ETHUSDT", "BINANCE:BTCUSDT"}, Interval1Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if path != "/global/scan" {
+		t.Fatalf("unexpected path %q", path)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+
+	btc := results["BINANCE:BTCUSDT"]
+	if btc.Recommend.Global.Summary != SignalStrongBuy || btc.Value.Prices.Close != 70998.71 {
+		t.Fatalf("unexpected BTC result: %+v", btc)
+	}
+	if btc.Symbol != "BINANCE:BTCUSDT" || btc.Interval != Interval1Hour || btc.FetchedAt.IsZero() {
+		t.Fatalf("unexpected BTC source: %q %q %v", btc.Symbol, btc.Interval, btc.FetchedAt)
+	}
+	eth := results["BINANCE:ETHUSDT"]
+	if eth.Recommend.Global.Summary != SignalSell || eth.Value.Prices.Close != 3500.5 {
+		t.Fatalf("unexpected ETH result: %+v", eth)
+	}
+}
+
+func TestClient_GetManyPartialFailure(t *testing.T) {
+	server := httptest.NewServer(scanHandler(t, map[string]map[string]any{
+		"BINANCE:BTCUSDT": {"close": 70998.71},
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+	}
+
+	results, err := client.GetMany(context.Background(), []string{"BINANCE:BTCUSDT", "BINANCE:NOPE", "BTCUSDT"}, Interval1Day)
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected *BatchError, got %v", err)
+	}
+	if !errors.Is(batchErr.Errors["BINANCE:NOPE"], ErrSymbolNotFound) {
+		t.Fatalf("expected ErrSymbolNotFound for missing row, got %v", batchErr.Errors["BINANCE:NOPE"])
+	}
+	if !errors.Is(batchErr.Errors["BTCUSDT"], ErrInvalidSymbol) {
+		t.Fatalf("expected ErrInvalidSymbol for invalid symbol, got %v", batchErr.Errors["BTCUSDT"])
+	}
+	if !errors.Is(err, ErrSymbolNotFound) {
+		t.Fatal("expected batch error to match ErrSymbolNotFound")
+	}
+	if len(results) != 1 || results["BINANCE:BTCUSDT"].Value.Prices.Close != 70998.71 {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+}
+
+func TestClient_GetManyUnexpectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"unavailable"}`, http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+	}
+
+	results, err := client.GetMany(context.Background(), []string{"BINANCE:BTCUSDT"}, Interval1Hour)
+	if err == nil {
+		t.Fatal("expected error for non-200 response")
+	}
+	if results != nil {
+		t.Fatalf("expected nil results, got %v", results)
+	}
+}
+
+func Test_decodeRow(t *testing.T) {
+	columns := []string{"close", "high", "low"}
+	values := []json.RawMessage{json.RawMessage("1.5"), json.RawMessage("null"), json.RawMessage("0")}
+
+	got, strs, err := decodeRow(columns, values)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 2 || got["close"] != 1.5 || got["low"] != 0 {
+		t.Fatalf("unexpected row: %v", got)
+	}
+	if _, ok := got["high"]; ok || strs != nil {
+		t.Fatal("expected null value to be left out")
+	}
+
+	if _, _, err := decodeRow(columns, values[:1]); err == nil {
+		t.Fatal("expected error for mismatched column count")
+	}
+}
+
+func TestClient_scanURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *Client
+		want   string
+	}{
+		{name: "nil client", client: nil, want: "https://scanner.tradingview.com/global/scan"},
+		{name: "default", client: &Client{}, want: "https://scanner.tradingview.com/global/scan"},
+		{name: "trailing slash", client: &Client{ScanBaseURL: "http://localhost/"}, want: "http://localhost/global/scan"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.client.scanURL("global"); got != tt.want {
+				t.Fatalf("scanURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetManyStrict(t *testing.T) {
+	server := httptest.NewServer(scanHandler(t, map[string]map[string]any{
+		"BINANCE:BTCUSDT": {"close": 70998.71},
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+		Strict:      true,
+	}
+
+	results, err := client.GetMany(context.Background(), []string{"BINANCE:BTCUSDT"}, Interval1Day)
+	if !errors.Is(err, ErrMissingFields) {
+		t.Fatalf("expected ErrMissingFields, got %v", err)
+	}
+	if results["BINANCE:BTCUSDT"] == nil {
+		t.Fatal("expected result to be returned in strict mode")
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long Cache keeps results for intervals without a
+// TTL of their own when Cache.TTL is zero.
+const DefaultCacheTTL = 15 * time.Second
+
+// Cache keeps recent results of Client.Get in memory.
+//
+// Results are keyed by symbol and interval. Concurrent requests for the same
+// key share one HTTP request, and every caller receives its own deep copy,
+// so modifying a result does not affect the cache or other callers. Errors
+// are not cached. A Cache is safe for concurrent use and may be shared
+// between clients that request the same data. The zero value is ready to
+// use.
+type Cache struct {
+	// TTL is how long results are kept. Zero means DefaultCacheTTL.
+	TTL time.Duration
+	// IntervalTTL overrides TTL for specific intervals, so that
+	// Interval1Min data can expire sooner than Interval1Day data. It must
+	// not be modified while the Cache is in use.
+	IntervalTTL map[Interval]time.Duration
+	// MaxEntries limits the number of results kept. When it is exceeded, the
+	// least recently used result is evicted. Zero means no limit.
+	MaxEntries int
+	// Clock is used to expire results. Nil means SystemClock.
+	Clock Clock
+
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element // values are *cacheEntry
+	lru     list.List                  // front is most recently used
+	calls   map[cacheKey]*cacheCall
+	stats   CacheStats
+}
+
+// CacheStats reports Cache activity.
+type CacheStats struct {
+	// Hits counts requests served without a new HTTP request, including
+	// requests that waited for an identical request in flight.
+	Hits uint64
+	// Misses counts requests that sent an HTTP request.
+	Misses uint64
+	// Evictions counts results removed to stay within MaxEntries.
+	Evictions uint64
+	// Entries is the number of results currently kept, including expired
+	// results that have not been removed yet.
+	Entries int
+}
+
+// cacheKey identifies a cached result. Interval is the scanner field
+// suffix, so aliases of the same interval share a key. Rules and extra, the
+// FieldSet.key of Client.Extra, keep results of clients with different
+// rules or extra fields apart.
+type cacheKey struct {
+	symbol   string
+	interval string
+	rules    *RuleSet
+	extra    string
+}
+
+type cacheEntry struct {
+	key     cacheKey
+	ta      *TradingView
+	expires time.Time
+}
+
+// cacheCall is a request in flight. Done is closed when ta and err are set.
+type cacheCall struct {
+	done chan struct{}
+	ta   *TradingView
+	err  error
+}
+
+// NewCache returns a Cache that keeps up to maxEntries results for ttl.
+func NewCache(ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{TTL: ttl, MaxEntries: maxEntries}
+}
+
+// Stats returns counters describing the cache's activity.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	return stats
+}
+
+// Clear removes all results from the cache. Requests in flight are not
+// affected.
+func (c *Cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = nil
+	c.lru.Init()
+}
+
+// get returns a copy of the result for key, calling fetch if there is no
+// fresh result and no identical request in flight.
+func (c *Cache) get(ctx context.Context, key cacheKey, interval Interval, fetch func() (*TradingView, error)) (*TradingView, error) {
+	for {
+		c.mu.Lock()
+		if ta, ok := c.lookup(key); ok {
+			c.stats.Hits++
+			c.mu.Unlock()
+			return ta.Clone(), nil
+		}
+
+		if call, ok := c.calls[key]; ok {
+			c.stats.Hits++
+			c.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-call.done:
+			}
+			// The caller that sent the request may have given up; if so,
+			// try again with ctx instead of reporting its context error.
+			if call.err != nil && ctx.Err() == nil && isContextError(call.err) {
+				continue
+			}
+			if call.err != nil {
+				return nil, call.err
+			}
+			return call.ta.Clone(), nil
+		}
+
+		call := &cacheCall{done: make(chan struct{})}
+		if c.calls == nil {
+			c.calls = make(map[cacheKey]*cacheCall)
+		}
+		c.calls[key] = call
+		c.stats.Misses++
+		c.mu.Unlock()
+
+		c.run(key, interval, call, fetch)
+		if call.err != nil {
+			return nil, call.err
+		}
+		return call.ta.Clone(), nil
+	}
+}
+
+// run sets the result of call by calling fetch, stores it if successful,
+// and releases the callers waiting for it. If fetch panics, the waiters
+// receive an error and the panic continues.
+func (c *Cache) run(key cacheKey, interval Interval, call *cacheCall, fetch func() (*TradingView, error)) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			call.ta, call.err = nil, fmt.Errorf("tradingview: request panicked: %v", r)
+		}
+
+		c.mu.Lock()
+		delete(c.calls, key)
+		if call.err == nil {
+			c.store(key, interval, call.ta)
+		}
+		c.mu.Unlock()
+		close(call.done)
+
+		if r != nil {
+			panic(r)
+		}
+	}()
+	call.ta, call.err = fetch()
+}
+
+// lookup returns the fresh result for key. It removes an expired result.
+// The caller must hold c.mu.
+func (c *Cache) lookup(key cacheKey) (*TradingView, bool) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !clockOrSystem(c.Clock).Now().Before(entry.expires) {
+		c.remove(elem)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.ta, true
+}
+
+// store adds ta under key and evicts the least recently used results over
+// MaxEntries. The caller must hold c.mu.
+func (c *Cache) store(key cacheKey, interval Interval, ta *TradingView) {
+	entry := &cacheEntry{
+		key:     key,
+		ta:      ta,
+		expires: clockOrSystem(c.Clock).Now().Add(c.ttl(interval)),
+	}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	if c.entries == nil {
+		c.entries = make(map[cacheKey]*list.Element)
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.MaxEntries > 0 && len(c.entries) > c.MaxEntries {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+// remove deletes elem from the cache. The caller must hold c.mu.
+func (c *Cache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).key)
+}
+
+// ttl returns how long results for interval are kept.
+func (c *Cache) ttl(interval Interval) time.Duration {
+	parsed, err := Interval1Day, error(nil)
+	if interval != "" {
+		parsed, err = ParseInterval(string(interval))
+	}
+	if ttl, ok := c.IntervalTTL[parsed]; ok && err == nil {
+		return ttl
+	}
+	if c.TTL > 0 {
+		return c.TTL
+	}
+	return DefaultCacheTTL
+}
+
+// Clone returns a deep copy of ta.
+func (ta *TradingView) Clone() *TradingView {
+	if ta == nil {
+		return nil
+	}
+	clone := *ta
+	clone.Missing = slices.Clone(ta.Missing)
+	clone.Raw = maps.Clone(ta.Raw)
+	clone.Strings = maps.Clone(ta.Strings)
+	return &clone
+}
+
+// isContextError reports whether err was caused by a canceled or expired
+// context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
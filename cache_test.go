@@ -0,0 +1,375 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingServer answers every request with a close price equal to the
+// number of requests served so far.
+func countingServer(t *testing.T, release <-chan struct{}) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if release != nil {
+			<-release
+		}
+		_, _ = fmt.Fprintf(w, `{"close": %d, "close|1": %d, "close|60": %d}`, n, n, n)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestCache_Get(t *testing.T) {
+	server, calls := countingServer(t, nil)
+	clock := newFakeClock()
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Cache:      &Cache{TTL: time.Minute, Clock: clock},
+	}
+
+	first := &TradingView{}
+	if err := client.Get(first, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	second := &TradingView{}
+	if err := client.Get(second, " binance:btcusdt ", "1h"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if calls.Load() != 1 || second.Value.Prices.Close != 1 {
+		t.Fatalf("expected cached result, got %d calls and close %v", calls.Load(), second.Value.Prices.Close)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	if err := client.Get(second, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if calls.Load() != 2 || second.Value.Prices.Close != 2 {
+		t.Fatalf("expected expired result to be refetched, got %d calls and close %v", calls.Load(), second.Value.Prices.Close)
+	}
+
+	want := CacheStats{Hits: 1, Misses: 2, Entries: 1}
+	if got := client.Cache.Stats(); got != want {
+		t.Fatalf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCache_GetKeys(t *testing.T) {
+	server, calls := countingServer(t, nil)
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Cache:      &Cache{Clock: newFakeClock()},
+	}
+
+	requests := []struct {
+		symbol   string
+		interval Interval
+	}{
+		{"BINANCE:BTCUSDT", Interval1Hour},
+		{"BINANCE:BTCUSDT", Interval1Min},
+		{"BINANCE:ETHUSDT", Interval1Hour},
+		{"BINANCE:BTCUSDT", ""},
+		{"BINANCE:BTCUSDT", Interval1Day},
+	}
+	for _, r := range requests {
+		if err := client.Get(&TradingView{}, r.symbol, r.interval); err != nil {
+			t.Fatalf("Get(%q, %q) error = %v", r.symbol, r.interval, err)
+		}
+	}
+	if calls.Load() != 4 {
+		t.Fatalf("expected 4 calls, got %d", calls.Load())
+	}
+}
+
+func TestCache_GetIntervalTTL(t *testing.T) {
+	server, calls := countingServer(t, nil)
+	clock := newFakeClock()
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Cache: &Cache{
+			TTL:         time.Hour,
+			IntervalTTL: map[Interval]time.Duration{Interval1Min: 10 * time.Second},
+			Clock:       clock,
+		},
+	}
+
+	get := func(interval Interval) {
+		t.Helper()
+		if err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", interval); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+	get(Interval1Min)
+	get(Interval1Day)
+	clock.now = clock.now.Add(time.Minute)
+	get("1m")
+	get(Interval1Day)
+
+	if calls.Load() != 3 {
+		t.Fatalf("expected only the 1m result to expire, got %d calls", calls.Load())
+	}
+}
+
+func TestCache_GetCoalesces(t *testing.T) {
+	release := make(chan struct{})
+	server, calls := countingServer(t, release)
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Cache:      &Cache{},
+	}
+
+	const callers = 10
+	results := make([]*TradingView, callers)
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = &TradingView{}
+			errs[i] = client.Get(results[i], "BINANCE:BTCUSDT", Interval1Hour)
+		}()
+	}
+
+	for client.Cache.Stats().Hits+client.Cache.Stats().Misses < callers {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 call, got %d", calls.Load())
+	}
+	for i := range callers {
+		if errs[i] != nil || results[i].Value.Prices.Close != 1 {
+			t.Fatalf("caller %d: got close %v, error %v", i, results[i].Value.Prices.Close, errs[i])
+		}
+	}
+}
+
+func TestCache_GetFetchPanics(t *testing.T) {
+	var cache Cache
+	key := cacheKey{symbol: "BINANCE:BTCUSDT", interval: "60"}
+	release := make(chan struct{})
+
+	leaderPanic := make(chan any, 1)
+	go func() {
+		defer func() { leaderPanic <- recover() }()
+		_, _ = cache.get(context.Background(), key, Interval1Hour, func() (*TradingView, error) {
+			<-release
+			panic("boom")
+		})
+	}()
+	for cache.Stats().Misses == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	waiterErr := make(chan error, 1)
+	go func() {
+		_, err := cache.get(context.Background(), key, Interval1Hour, func() (*TradingView, error) {
+			t.Error("waiter fetched instead of waiting")
+			return nil, nil
+		})
+		waiterErr <- err
+	}()
+	for cache.Stats().Hits == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	close(release)
+	if r := <-leaderPanic; r != "boom" {
+		t.Fatalf("expected the panic to reach the leader, got %v", r)
+	}
+	if err := <-waiterErr; err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected the waiter to get the panic as an error, got %v", err)
+	}
+
+	ta, err := cache.get(context.Background(), key, Interval1Hour, func() (*TradingView, error) {
+		return &TradingView{}, nil
+	})
+	if err != nil || ta == nil {
+		t.Fatalf("expected a later request to fetch again, got %v, %v", ta, err)
+	}
+}
+
+func TestCache_GetCoalescedLeaderCanceled(t *testing.T) {
+	release := make(chan struct{})
+	server, calls := countingServer(t, release)
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Cache:      &Cache{},
+	}
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		leaderErr <- client.GetContext(leaderCtx, &TradingView{}, "BINANCE:BTCUSDT", Interval1Hour)
+	}()
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	followerErr := make(chan error, 1)
+	follower := &TradingView{}
+	go func() {
+		followerErr <- client.Get(follower, "BINANCE:BTCUSDT", Interval1Hour)
+	}()
+	for client.Cache.Stats().Hits == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected leader to be canceled, got %v", err)
+	}
+	close(release)
+	if err := <-followerErr; err != nil {
+		t.Fatalf("expected follower to retry, got %v", err)
+	}
+	if follower.Value.Prices.Close != 2 {
+		t.Fatalf("expected follower to use its own request, got close %v", follower.Value.Prices.Close)
+	}
+}
+
+func TestCache_GetDeepCopy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"close|60": 1}`)
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Cache:      &Cache{},
+	}
+
+	first := &TradingView{}
+	if err := client.Get(first, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	first.Value.Prices.Close = 100
+	first.Missing[0] = "changed"
+	first.Raw["close"] = 100
+
+	second := &TradingView{}
+	if err := client.Get(second, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if second.Value.Prices.Close != 1 || second.Missing[0] == "changed" || second.Raw["close"] != 1 {
+		t.Fatalf("cached result was modified through a caller's copy: %+v", second)
+	}
+}
+
+func TestCache_GetErrorsNotCached(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = io.WriteString(w, `{"close": 1}`)
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Cache:      &Cache{},
+	}
+
+	if err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", ""); !errors.Is(err, ErrServerError) {
+		t.Fatalf("expected ErrServerError, got %v", err)
+	}
+	if err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", ""); err != nil {
+		t.Fatalf("expected error not to be cached, got %v", err)
+	}
+}
+
+func TestCache_GetStrict(t *testing.T) {
+	server, _ := countingServer(t, nil)
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Strict:     true,
+		Cache:      &Cache{},
+	}
+
+	for range 2 {
+		if err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", ""); !errors.Is(err, ErrMissingFields) {
+			t.Fatalf("expected ErrMissingFields for cached result too, got %v", err)
+		}
+	}
+}
+
+func TestCache_MaxEntries(t *testing.T) {
+	server, calls := countingServer(t, nil)
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Cache:      NewCache(time.Hour, 2),
+	}
+
+	for _, symbol := range []string{"BINANCE:A", "BINANCE:B", "BINANCE:A", "BINANCE:C", "BINANCE:A", "BINANCE:B"} {
+		if err := client.Get(&TradingView{}, symbol, ""); err != nil {
+			t.Fatalf("Get(%q) error = %v", symbol, err)
+		}
+	}
+
+	// A stays recently used, so adding C evicts B, which is fetched again.
+	want := CacheStats{Hits: 2, Misses: 4, Evictions: 2, Entries: 2}
+	if got := client.Cache.Stats(); got != want {
+		t.Fatalf("Stats() = %+v, want %+v", got, want)
+	}
+	if calls.Load() != 4 {
+		t.Fatalf("expected 4 calls, got %d", calls.Load())
+	}
+
+	client.Cache.Clear()
+	if got := client.Cache.Stats().Entries; got != 0 {
+		t.Fatalf("expected Clear to remove all entries, have %d", got)
+	}
+}
+
+func TestTradingView_Clone(t *testing.T) {
+	var nilTA *TradingView
+	if nilTA.Clone() != nil {
+		t.Fatal("expected Clone of nil to be nil")
+	}
+
+	ta := &TradingView{
+		Missing: []string{"RSI"},
+		Raw:     map[string]float64{"close": 1},
+		Strings: map[string]string{"description": "Bitcoin"},
+	}
+	ta.Recommend.Global.Summary = SignalBuy
+	clone := ta.Clone()
+	clone.Missing[0] = "changed"
+	clone.Raw["close"] = 2
+	clone.Strings["description"] = "changed"
+
+	if ta.Missing[0] != "RSI" || ta.Raw["close"] != 1 || ta.Strings["description"] != "Bitcoin" {
+		t.Fatalf("Clone shares state with the original: %+v", ta)
+	}
+	if clone.Recommend.Global.Summary != SignalBuy {
+		t.Fatalf("Clone lost values: %+v", clone)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Command tvta prints TradingView recommendations and indicator values for
+// one or more symbols.
+//
+// Usage:
+//
+//	tvta [flags] SYMBOL...
+//
+// For example:
+//
+//	tvta -interval 1h,4h -format json BINANCE:BTCUSDT NASDAQ:AAPL
+//	tvta -format csv -fields global,osc.rsi,price.close BINANCE:ETHUSDT
+//
+// The flags are:
+//
+//	-interval list
+//		comma-separated intervals, such as 15m,1h,1d (default 1d)
+//	-format table|json|csv|ndjson
+//		output format (default table); json writes an array of the
+//		JSON encoding of each result, with every field
+//	-fields list
+//		comma-separated columns to print, or column prefixes such as
+//		osc.rsi or pivot.classic (default all); not used with
+//		-format json
+//	-base-url url
+//		scanner endpoint (default TradingView's public endpoint)
+//	-timeout duration
+//		timeout of each request (default 10s)
+//
+// Columns are named as in the export package, so csv and ndjson output can
+// be read back with export.ReadCSV and export.ReadNDJSON. Selected columns
+// always start with symbol and interval. Without -fields, ndjson writes the
+// JSON encoding of each result, one per line.
+//
+// Flags must come before the symbols. Every symbol is fetched at every
+// interval; failures are reported on standard error and the remaining
+// lookups continue.
+//
+// The exit status is 0 on success, 2 for invalid usage, 3 if a symbol is
+// invalid or unknown, 4 for network errors, 5 if TradingView returned an
+// error or an unreadable response, and 1 for other errors. If several
+// lookups fail, the status reflects the first failure.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// Exit statuses.
+const (
+	exitOK      = 0
+	exitError   = 1
+	exitUsage   = 2
+	exitSymbol  = 3
+	exitNetwork = 4
+	exitAPI     = 5
+)
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs tvta with args and returns the exit status.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("tvta", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: tvta [flags] SYMBOL...")
+		flags.PrintDefaults()
+	}
+	intervalList := flags.String("interval", "1d", "comma-separated `list` of intervals, such as 15m,1h,1d")
+	format := flags.String("format", "table", "output `format`: table, json, csv or ndjson")
+	fieldList := flags.String("fields", "", "comma-separated `list` of columns or column prefixes to print")
+	baseURL := flags.String("base-url", "", "scanner endpoint `url`")
+	timeout := flags.Duration("timeout", 10*time.Second, "timeout of each request")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	symbols := flags.Args()
+	if len(symbols) == 0 {
+		flags.Usage()
+		return exitUsage
+	}
+	write, ok := writers[*format]
+	if !ok {
+		fmt.Fprintf(stderr, "tvta: unknown format %q\n", *format)
+		return exitUsage
+	}
+	intervals := splitList(*intervalList)
+	for _, interval := range intervals {
+		if _, err := tradingview.ParseInterval(interval); err != nil {
+			fmt.Fprintf(stderr, "tvta: %v\n", err)
+			return exitUsage
+		}
+	}
+	if *format == "json" && *fieldList != "" {
+		fmt.Fprintln(stderr, "tvta: -fields does not apply to -format json")
+		return exitUsage
+	}
+	columns, err := selectColumns(splitList(*fieldList))
+	if err != nil {
+		fmt.Fprintf(stderr, "tvta: %v\n", err)
+		return exitUsage
+	}
+
+	client := tradingview.Client{
+		HTTPClient: &http.Client{Timeout: *timeout},
+		BaseURL:    *baseURL,
+	}
+
+	status := exitOK
+	var results []*tradingview.TradingView
+	for _, symbol := range symbols {
+		for _, interval := range intervals {
+			ta := &tradingview.TradingView{}
+			if err := client.GetContext(ctx, ta, symbol, tradingview.Interval(interval)); err != nil {
+				fmt.Fprintf(stderr, "tvta: %s %s: %v\n", symbol, interval, err)
+				if status == exitOK {
+					status = exitCode(err)
+				}
+				continue
+			}
+			results = append(results, ta)
+		}
+	}
+
+	if err := write(stdout, columns, results); err != nil {
+		fmt.Fprintf(stderr, "tvta: %v\n", err)
+		if status == exitOK {
+			status = exitError
+		}
+	}
+	return status
+}
+
+// exitCode returns the exit status for a failed lookup.
+func exitCode(err error) int {
+	var apiErr *tradingview.APIError
+	var decodeErr *tradingview.DecodeError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, tradingview.ErrInvalidSymbol), errors.Is(err, tradingview.ErrSymbolNotFound):
+		return exitSymbol
+	case errors.As(err, &apiErr), errors.As(err, &decodeErr):
+		return exitAPI
+	case errors.As(err, &netErr), errors.Is(err, context.DeadlineExceeded):
+		return exitNetwork
+	default:
+		return exitError
+	}
+}
+
+// splitList splits a comma-separated list, dropping empty items.
+func splitList(list string) []string {
+	var items []string
+	for item := range strings.SplitSeq(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,192 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+	"github.com/artlevitan/go-tradingview-ta/export"
+)
+
+// scanner serves a fixed response for BINANCE:BTCUSDT, 404 for
+// BINANCE:MISSING and 500 for anything else.
+func scanner(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("symbol") {
+		case "BINANCE:BTCUSDT":
+			_, _ = w.Write([]byte(`{"Recommend.All|60": 0.6, "close|60": 70998.71, "RSI|60": 25, "RSI[1]|60": 20,
+				"Recommend.All": -0.2, "close": 70000}`))
+		case "BINANCE:MISSING":
+			http.Error(w, "not found", http.StatusNotFound)
+		default:
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func runTvta(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRunTable(t *testing.T) {
+	server := scanner(t)
+
+	code, out, errOut := runTvta(t, "-base-url", server.URL, "-interval", "1h", "BINANCE:BTCUSDT")
+	if code != exitOK {
+		t.Fatalf("exit status %d, stderr %q", code, errOut)
+	}
+	lines := make(map[string]string)
+	for line := range strings.Lines(out) {
+		if fields := strings.Fields(line); len(fields) == 2 {
+			lines[fields[0]] = fields[1]
+		}
+	}
+	for name, want := range map[string]string{
+		"symbol":                "BINANCE:BTCUSDT",
+		"interval":              "60",
+		"global.summary.signal": "STRONG_BUY",
+		"osc.rsi.signal":        "BUY",
+		"price.close":           "70998.71",
+		"pivot.classic.r1":      "0",
+	} {
+		if lines[name] != want {
+			t.Fatalf("%s = %q, want %q in output:\n%s", name, lines[name], want, out)
+		}
+	}
+	if len(lines) != len(export.Columns()) {
+		t.Fatalf("expected every export column, got %d lines", len(lines))
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	server := scanner(t)
+
+	code, out, errOut := runTvta(t, "-base-url", server.URL, "-interval", "1h,1d", "-format", "json", "BINANCE:BTCUSDT")
+	if code != exitOK {
+		t.Fatalf("exit status %d, stderr %q", code, errOut)
+	}
+
+	var got []*tradingview.TradingView
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", out, err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(got))
+	}
+	first, second := got[0], got[1]
+	if first.Symbol != "BINANCE:BTCUSDT" || first.Interval != tradingview.Interval1Hour ||
+		first.Recommend.Global.Summary != tradingview.SignalStrongBuy || first.Value.Prices.Close != 70998.71 {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	if second.Interval != tradingview.Interval1Day || second.Recommend.Global.Summary != tradingview.SignalSell {
+		t.Fatalf("unexpected second result: %+v", second)
+	}
+	if !strings.HasPrefix(out, "[\n  {\n    \"symbol\": \"BINANCE:BTCUSDT\",\n    \"interval\": \"60\"") {
+		t.Fatalf("expected the indented JSON encoding, got %q", out)
+	}
+
+	if code, out, _ := runTvta(t, "-base-url", server.URL, "-format", "json", "BINANCE:MISSING"); code != exitSymbol || out != "[]\n" {
+		t.Fatalf("expected an empty array, got status %d, output %q", code, out)
+	}
+}
+
+func TestRunCSVAndNDJSON(t *testing.T) {
+	server := scanner(t)
+
+	code, out, _ := runTvta(t, "-base-url", server.URL, "-interval", "1h", "-format", "csv",
+		"-fields", "price.close,osc.rsi.signal", "BINANCE:BTCUSDT")
+	if code != exitOK {
+		t.Fatalf("csv: exit status %d", code)
+	}
+	want := "symbol,interval,price.close,osc.rsi.signal\nBINANCE:BTCUSDT,60,70998.71,BUY\n"
+	if out != want {
+		t.Fatalf("csv = %q, want %q", out, want)
+	}
+	results, err := export.ReadCSV(strings.NewReader(out))
+	if err != nil || len(results) != 1 || results[0].Value.Prices.Close != 70998.71 {
+		t.Fatalf("expected the csv to read back, got %v, %v", results, err)
+	}
+
+	code, out, _ = runTvta(t, "-base-url", server.URL, "-interval", "1h,1d", "-format", "ndjson",
+		"-fields", "price.close", "BINANCE:BTCUSDT")
+	if code != exitOK {
+		t.Fatalf("ndjson: exit status %d", code)
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) != 2 || lines[1] != `{"symbol":"BINANCE:BTCUSDT","interval":"1D","price.close":70000}` {
+		t.Fatalf("unexpected ndjson: %q", out)
+	}
+
+	code, out, _ = runTvta(t, "-base-url", server.URL, "-format", "ndjson", "BINANCE:BTCUSDT")
+	if code != exitOK {
+		t.Fatalf("ndjson: exit status %d", code)
+	}
+	results, err = export.ReadNDJSON(strings.NewReader(out))
+	if err != nil || len(results) != 1 || results[0].Recommend.Global.Summary != tradingview.SignalSell {
+		t.Fatalf("expected the full JSON encoding, got %q, %v", out, err)
+	}
+}
+
+func TestRunExitCodes(t *testing.T) {
+	server := scanner(t)
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"no symbols", []string{}, exitUsage},
+		{"unknown flag", []string{"-nope", "BINANCE:BTCUSDT"}, exitUsage},
+		{"unknown format", []string{"-format", "xml", "BINANCE:BTCUSDT"}, exitUsage},
+		{"invalid interval", []string{"-interval", "7m", "BINANCE:BTCUSDT"}, exitUsage},
+		{"unknown field", []string{"-fields", "osc.nope", "BINANCE:BTCUSDT"}, exitUsage},
+		{"old field name", []string{"-fields", "value.prices.close", "BINANCE:BTCUSDT"}, exitUsage},
+		{"fields with json", []string{"-format", "json", "-fields", "price", "BINANCE:BTCUSDT"}, exitUsage},
+		{"invalid symbol", []string{"-base-url", server.URL, "BTCUSDT"}, exitSymbol},
+		{"unknown symbol", []string{"-base-url", server.URL, "BINANCE:MISSING"}, exitSymbol},
+		{"api error", []string{"-base-url", server.URL, "BINANCE:ETHUSDT"}, exitAPI},
+		{"network error", []string{"-base-url", unreachable.URL, "BINANCE:BTCUSDT"}, exitNetwork},
+		{"first failure wins", []string{"-base-url", server.URL, "BINANCE:ETHUSDT", "BTCUSDT"}, exitAPI},
+		{"help", []string{"-h"}, exitOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code, _, _ := runTvta(t, tt.args...); code != tt.want {
+				t.Fatalf("exit status %d, want %d", code, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunPartialFailure(t *testing.T) {
+	server := scanner(t)
+
+	code, out, errOut := runTvta(t, "-base-url", server.URL, "-format", "ndjson", "-fields", "price.close",
+		"BINANCE:MISSING", "BINANCE:BTCUSDT")
+	if code != exitSymbol {
+		t.Fatalf("exit status %d, want %d", code, exitSymbol)
+	}
+	if !strings.Contains(errOut, "BINANCE:MISSING") {
+		t.Fatalf("expected the failure on stderr, got %q", errOut)
+	}
+	if out != `{"symbol":"BINANCE:BTCUSDT","interval":"1D","price.close":70000}`+"\n" {
+		t.Fatalf("expected the successful lookup on stdout, got %q", out)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+	"github.com/artlevitan/go-tradingview-ta/export"
+)
+
+// writers maps the -format values to their output functions. Columns are
+// export column names; nil selects the default columns of the format.
+var writers = map[string]func(w io.Writer, columns []string, results []*tradingview.TradingView) error{
+	"table": writeTable,
+	"json":  writeJSON,
+	"csv": func(w io.Writer, columns []string, results []*tradingview.TradingView) error {
+		return export.WriteCSV(w, results, columns...)
+	},
+	"ndjson": func(w io.Writer, columns []string, results []*tradingview.TradingView) error {
+		return export.WriteNDJSON(w, results, columns...)
+	},
+}
+
+// selectColumns returns the export columns matching fields, each a column
+// name or a prefix of column names, after the symbol and interval. No
+// fields returns nil.
+func selectColumns(fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return export.SelectColumns(append([]string{"symbol", "interval"}, fields...)...)
+}
+
+// writeTable writes each result as lines of column names and values,
+// separated by blank lines.
+func writeTable(w io.Writer, columns []string, results []*tradingview.TradingView) error {
+	if columns == nil {
+		columns = export.Columns()
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for i, ta := range results {
+		values, err := export.Record(ta, columns...)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Fprintln(tw)
+		}
+		for j, v := range values {
+			fmt.Fprintf(tw, "%s\t%s\n", columns[j], v)
+		}
+	}
+	return tw.Flush()
+}
+
+// writeJSON writes the results as an indented JSON array of their JSON
+// encoding. It writes every field, so columns are not used.
+func writeJSON(w io.Writer, columns []string, results []*tradingview.TradingView) error {
+	if results == nil {
+		results = []*tradingview.TradingView{}
+	}
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,84 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+// Counts tallies the signals of a group of indicators, as in TradingView's
+// "Buy 10 / Neutral 6 / Sell 1" summary. Indicators without data are neutral
+// and counted as such.
+type Counts struct {
+	Buy     int // Number of buy and strong buy signals
+	Sell    int // Number of sell and strong sell signals
+	Neutral int // Number of neutral signals
+	Total   int // Number of indicators
+}
+
+// Score returns the share of buy signals minus the share of sell signals,
+// between -1 and 1. It is 0 when Total is 0.
+func (c Counts) Score() float64 {
+	if c.Total == 0 {
+		return 0
+	}
+	return float64(c.Buy-c.Sell) / float64(c.Total)
+}
+
+// add returns the sum of c and o.
+func (c Counts) add(o Counts) Counts {
+	return Counts{
+		Buy:     c.Buy + o.Buy,
+		Sell:    c.Sell + o.Sell,
+		Neutral: c.Neutral + o.Neutral,
+		Total:   c.Total + o.Total,
+	}
+}
+
+// Counts tallies the oscillator signals.
+func (r OscillatorRecommendations) Counts() Counts {
+	return countSignals(r.signals())
+}
+
+// Counts tallies the moving-average signals.
+func (r MovingAverageRecommendations) Counts() Counts {
+	return countSignals(r.signals())
+}
+
+// Counts tallies the oscillator and moving-average signals together.
+func (r Recommendations) Counts() Counts {
+	return r.Oscillators.Counts().add(r.MovingAverages.Counts())
+}
+
+// Score returns a summary score computed from the individual signals, the
+// mean of the oscillator and moving-average scores, for comparison with
+// TradingView's own Value.Global.Summary.
+func (r Recommendations) Score() float64 {
+	return (r.Oscillators.Counts().Score() + r.MovingAverages.Counts().Score()) / 2
+}
+
+// signals returns the oscillator signals in field order.
+func (r OscillatorRecommendations) signals() []Signal {
+	return []Signal{r.RSI, r.StochK, r.CCI, r.ADX, r.AO, r.Mom, r.MACD, r.StochRSI, r.WR, r.BBP, r.UO}
+}
+
+// signals returns the moving-average signals in field order.
+func (r MovingAverageRecommendations) signals() []Signal {
+	return []Signal{
+		r.EMA10, r.SMA10, r.EMA20, r.SMA20, r.EMA30, r.SMA30, r.EMA50, r.SMA50,
+		r.EMA100, r.SMA100, r.EMA200, r.SMA200, r.Ichimoku, r.VWMA, r.HullMA,
+	}
+}
+
+func countSignals(signals []Signal) Counts {
+	c := Counts{Total: len(signals)}
+	for _, s := range signals {
+		switch {
+		case s.IsBullish():
+			c.Buy++
+		case s.IsBearish():
+			c.Sell++
+		default:
+			c.Neutral++
+		}
+	}
+	return c
+}
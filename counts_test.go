@@ -0,0 +1,65 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import "testing"
+
+func TestCounts(t *testing.T) {
+	r := Recommendations{
+		Oscillators: OscillatorRecommendations{
+			RSI:  SignalBuy,
+			CCI:  SignalStrongBuy,
+			AO:   SignalSell,
+			MACD: SignalBuy,
+		},
+		MovingAverages: MovingAverageRecommendations{
+			EMA10:  SignalBuy,
+			SMA10:  SignalBuy,
+			EMA200: SignalSell,
+			SMA200: SignalStrongSell,
+		},
+	}
+
+	tests := []struct {
+		name string
+		got  Counts
+		want Counts
+	}{
+		{"oscillators", r.Oscillators.Counts(), Counts{Buy: 3, Sell: 1, Neutral: 7, Total: 11}},
+		{"moving averages", r.MovingAverages.Counts(), Counts{Buy: 2, Sell: 2, Neutral: 11, Total: 15}},
+		{"overall", r.Counts(), Counts{Buy: 5, Sell: 3, Neutral: 18, Total: 26}},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if want := (2.0/11 + 0) / 2; r.Score() != want {
+		t.Fatalf("Score() = %v, want %v", r.Score(), want)
+	}
+	if got := (Counts{}).Score(); got != 0 {
+		t.Fatalf("zero Counts.Score() = %v, want 0", got)
+	}
+}
+
+func TestCountsMatchServerScore(t *testing.T) {
+	ta := FromFields(map[string]float64{
+		"RSI":    25,
+		"RSI[1]": 20,
+		"Rec.UO": 1,
+		"Rec.WR": -1,
+		"EMA10":  90,
+		"SMA10":  110,
+		"close":  100,
+	})
+
+	if got, want := ta.Recommend.Score(), ta.Value.Global.Summary; got != want {
+		t.Fatalf("Score() = %v, want Recommend.All %v", got, want)
+	}
+	if got, want := ta.Recommend.Oscillators.Counts().Score(), ta.Value.Global.Oscillators; got != want {
+		t.Fatalf("oscillator score = %v, want Recommend.Other %v", got, want)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrRateLimited reports that TradingView rejected a request because of
+	// rate limiting (HTTP 429).
+	ErrRateLimited = errors.New("tradingview: rate limited")
+	// ErrServerError reports that TradingView failed with a 5xx status.
+	ErrServerError = errors.New("tradingview: server error")
+)
+
+const (
+	// maxErrorBody is the number of response body bytes kept in an APIError.
+	maxErrorBody = 512
+	// snippetRadius is the number of bytes kept on each side of a decode
+	// error offset in a DecodeError.
+	snippetRadius = 40
+)
+
+// APIError reports a response from TradingView with a status other than
+// 200 OK.
+//
+// It matches ErrSymbolNotFound for 404 responses, ErrRateLimited for 429
+// responses and ErrServerError for 5xx responses with errors.Is.
+type APIError struct {
+	// StatusCode is the HTTP status code, such as 429.
+	StatusCode int
+	// Status is the HTTP status line, such as "429 Too Many Requests".
+	Status string
+	// Body is the start of the response body, truncated to 512 bytes.
+	Body string
+	// Symbol is the requested symbol. It is empty for requests that cover
+	// several symbols.
+	Symbol string
+	// Interval is the requested interval. It is empty for requests that
+	// cover several intervals.
+	Interval Interval
+	// RetryAfter is the delay requested by the Retry-After header, or 0 if
+	// the header is absent or invalid.
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "tradingview: unexpected response status %s", e.Status)
+	if e.Symbol != "" {
+		fmt.Fprintf(&b, " for %s", e.Symbol)
+	}
+	if e.Body != "" {
+		fmt.Fprintf(&b, ": %s", e.Body)
+	}
+	return b.String()
+}
+
+// Is reports whether target is the sentinel error for e's status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrSymbolNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= 500 && e.StatusCode <= 599
+	default:
+		return false
+	}
+}
+
+// newAPIError builds an APIError from a non-200 response and its body. A
+// Retry-After date is measured from now.
+func newAPIError(res *http.Response, body []byte, info requestInfo, now time.Time) *APIError {
+	text := strings.TrimSpace(string(body))
+	if len(text) > maxErrorBody {
+		// Cut at a rune boundary so the body stays valid UTF-8.
+		n := maxErrorBody
+		for n > 0 && !utf8.RuneStart(text[n]) {
+			n--
+		}
+		text = text[:n] + "..."
+	}
+	return &APIError{
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Body:       text,
+		Symbol:     info.symbol,
+		Interval:   info.interval,
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), now),
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an
+// HTTP date relative to now. It returns 0 if value is empty or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := at.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// DecodeError reports a response body that could not be decoded.
+type DecodeError struct {
+	// Snippet is the part of the body around the point where decoding
+	// failed.
+	Snippet string
+	// Offset is the byte offset in the body where decoding failed, or -1 if
+	// it is unknown.
+	Offset int64
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	if e.Snippet == "" {
+		return fmt.Sprintf("tradingview: parse response: %v", e.Err)
+	}
+	return fmt.Sprintf("tradingview: parse response: %v near %q", e.Err, e.Snippet)
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// newDecodeError builds a DecodeError for err while decoding data.
+func newDecodeError(data []byte, err error) *DecodeError {
+	offset := int64(-1)
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	}
+
+	start, end := int64(0), int64(len(data))
+	if offset >= 0 {
+		start = max(offset-snippetRadius, 0)
+		end = min(offset+snippetRadius, end)
+	} else {
+		end = min(2*snippetRadius, end)
+	}
+	return &DecodeError{
+		Snippet: string(data[start:end]),
+		Offset:  offset,
+		Err:     err,
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestClient_GetAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter string
+		sentinel   error
+		wantDelay  time.Duration
+	}{
+		{name: "not found", status: http.StatusNotFound, sentinel: ErrSymbolNotFound},
+		{name: "rate limited", status: http.StatusTooManyRequests, retryAfter: "7", sentinel: ErrRateLimited, wantDelay: 7 * time.Second},
+		{name: "bad gateway", status: http.StatusBadGateway, sentinel: ErrServerError},
+		{name: "unavailable", status: http.StatusServiceUnavailable, sentinel: ErrServerError},
+	}
+	sentinels := []error{ErrSymbolNotFound, ErrRateLimited, ErrServerError}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				http.Error(w, `{"error":"`+tt.name+`"}`, tt.status)
+			}))
+			defer server.Close()
+
+			client := Client{
+				HTTPClient: server.Client(),
+				BaseURL:    server.URL,
+			}
+
+			err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", Interval1Hour)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %v", err)
+			}
+			if apiErr.StatusCode != tt.status || apiErr.Symbol != "BINANCE:BTCUSDT" || apiErr.Interval != Interval1Hour {
+				t.Fatalf("unexpected error fields: %+v", apiErr)
+			}
+			if apiErr.Body != `{"error":"`+tt.name+`"}` {
+				t.Fatalf("unexpected body %q", apiErr.Body)
+			}
+			if apiErr.RetryAfter != tt.wantDelay {
+				t.Fatalf("RetryAfter = %v, want %v", apiErr.RetryAfter, tt.wantDelay)
+			}
+			for _, sentinel := range sentinels {
+				if got, want := errors.Is(err, sentinel), sentinel == tt.sentinel; got != want {
+					t.Fatalf("errors.Is(err, %v) = %v, want %v", sentinel, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	err := &APIError{Status: "502 Bad Gateway", Symbol: "BINANCE:BTCUSDT", Body: "oops"}
+	if want := "tradingview: unexpected response status 502 Bad Gateway for BINANCE:BTCUSDT: oops"; err.Error() != want {
+		t.Fatalf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	err = &APIError{Status: "500 Internal Server Error"}
+	if want := "tradingview: unexpected response status 500 Internal Server Error"; err.Error() != want {
+		t.Fatalf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestAPIError_TruncatesBody(t *testing.T) {
+	body := strings.Repeat("x", 2*maxErrorBody)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, body, http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+	}
+
+	_, err := client.GetMany(context.Background(), []string{"BINANCE:BTCUSDT"}, Interval1Hour)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %v", err)
+	}
+	if len(apiErr.Body) != maxErrorBody+len("...") {
+		t.Fatalf("expected truncated body, got %d bytes", len(apiErr.Body))
+	}
+	if apiErr.Symbol != "" || apiErr.Interval != Interval1Hour {
+		t.Fatalf("unexpected error fields: %+v", apiErr)
+	}
+}
+
+func TestAPIError_TruncatesBodyAtRune(t *testing.T) {
+	// "x" shifts the two-byte runes so that byte maxErrorBody falls inside
+	// one.
+	body := "x" + strings.Repeat("é", maxErrorBody)
+	res := &http.Response{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"}
+
+	apiErr := newAPIError(res, []byte(body), requestInfo{}, time.Now())
+	if !utf8.ValidString(apiErr.Body) || !utf8.ValidString(apiErr.Error()) {
+		t.Fatalf("truncated body is not valid UTF-8: %q", apiErr.Body)
+	}
+	if want := body[:maxErrorBody-1] + "..."; apiErr.Body != want {
+		t.Fatalf("Body = %q, want %q", apiErr.Body, want)
+	}
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "empty", value: "", want: 0},
+		{name: "seconds", value: "120", want: 2 * time.Minute},
+		{name: "negative", value: "-1", want: 0},
+		{name: "date", value: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second},
+		{name: "past date", value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0},
+		{name: "invalid", value: "soon", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Fatalf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetDecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"close|60": 1, "high|60": "oops"}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", Interval1Hour)
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected *DecodeError, got %v", err)
+	}
+	if decodeErr.Offset < 0 || !strings.Contains(decodeErr.Snippet, `"oops"`) {
+		t.Fatalf("unexpected decode error: %+v", decodeErr)
+	}
+	if !strings.Contains(err.Error(), `oops`) {
+		t.Fatalf("expected snippet in message, got %q", err.Error())
+	}
+}
+
+func Test_newDecodeError(t *testing.T) {
+	data := []byte(`{"close": ` + strings.Repeat(" ", 100) + `!}`)
+	var v map[string]float64
+	err := newDecodeError(data, json.Unmarshal(data, &v))
+	if err.Offset != 111 || err.Snippet != string(data[111-snippetRadius:]) {
+		t.Fatalf("unexpected snippet %q at %d", err.Snippet, err.Offset)
+	}
+
+	err = newDecodeError([]byte(strings.Repeat("x", 200)), errors.New("boom"))
+	if err.Offset != -1 || len(err.Snippet) != 2*snippetRadius {
+		t.Fatalf("unexpected snippet %q at %d", err.Snippet, err.Offset)
+	}
+	if !strings.HasPrefix(err.Error(), "tradingview: parse response: boom near ") {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+	if !errors.Is(err, err.Err) {
+		t.Fatal("expected DecodeError to unwrap to its cause")
+	}
+}
@@ -43,7 +43,7 @@ func ExampleTradingView_Get() {
 	fmt.Println(ta.Recommend.Global.Summary)
 	fmt.Println(ta.Value.Prices.Close)
 	// Output:
-	// 1
+	// BUY
 	// 70998.71
 }
 
@@ -73,6 +73,24 @@ func ExampleClient_Get() {
 	fmt.Println(ta.Recommend.Global.Summary)
 	fmt.Println(ta.Value.Prices.Close)
 	// Output:
-	// 1
+	// BUY
 	// 70998.71
 }
+
+func ExampleFromFields() {
+	ta := tradingview.FromFields(map[string]float64{
+		"RSI":    25,
+		"RSI[1]": 20,
+		"EMA10":  95,
+		"SMA10":  105,
+		"close":  100,
+	})
+
+	fmt.Println(ta.Recommend.Oscillators.RSI)
+	fmt.Println(ta.Recommend.MovingAverages.EMA10, ta.Recommend.MovingAverages.SMA10)
+	fmt.Println(ta.Recommend.Global.Summary, ta.IsSet("EMA20"))
+	// Output:
+	// BUY
+	// BUY SELL
+	// NEUTRAL false
+}
@@ -0,0 +1,147 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// CSVWriter writes TradingView results as CSV rows, preceded by a header
+// row of column names.
+type CSVWriter struct {
+	w      *csv.Writer
+	cols   []*column
+	header bool // whether the header row has been written
+}
+
+// NewCSVWriter returns a CSVWriter writing columns, selected as by
+// SelectColumns, to w.
+func NewCSVWriter(w io.Writer, columns ...string) (*CSVWriter, error) {
+	cols, err := selectColumns(columns)
+	if err != nil {
+		return nil, err
+	}
+	return &CSVWriter{w: csv.NewWriter(w), cols: cols}, nil
+}
+
+// Write writes ta as one row. Rows are buffered until Flush. A nil ta is
+// reported with ErrNilResult.
+func (w *CSVWriter) Write(ta *tradingview.TradingView) error {
+	if ta == nil {
+		return ErrNilResult
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	fields, err := record(ta, w.cols)
+	if err != nil {
+		return err
+	}
+	return w.w.Write(fields)
+}
+
+// Flush writes any buffered rows, and the header row if no row was
+// written, to the underlying writer.
+func (w *CSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *CSVWriter) writeHeader() error {
+	if w.header {
+		return nil
+	}
+	w.header = true
+	names := make([]string, len(w.cols))
+	for i, c := range w.cols {
+		names[i] = c.name
+	}
+	return w.w.Write(names)
+}
+
+// WriteCSV writes results to w as CSV with the selected columns. A nil
+// result stops the output with an error naming its index.
+func WriteCSV(w io.Writer, results []*tradingview.TradingView, columns ...string) error {
+	cw, err := NewCSVWriter(w, columns...)
+	if err != nil {
+		return err
+	}
+	for i, ta := range results {
+		if err := cw.Write(ta); err != nil {
+			return fmt.Errorf("result %d: %w", i, err)
+		}
+	}
+	return cw.Flush()
+}
+
+// CSVReader reads TradingView results written by a CSVWriter. Columns are
+// matched by the names in the header row; unknown columns are ignored and
+// absent columns are left at their zero value.
+type CSVReader struct {
+	r    *csv.Reader
+	cols []*column // nil entries are unknown columns
+}
+
+// NewCSVReader returns a CSVReader reading from r.
+func NewCSVReader(r io.Reader) *CSVReader {
+	return &CSVReader{r: csv.NewReader(r)}
+}
+
+// Read reads the next result. It returns io.EOF when there are no more.
+func (r *CSVReader) Read() (*tradingview.TradingView, error) {
+	if r.cols == nil {
+		header, err := r.r.Read()
+		if err != nil {
+			return nil, err
+		}
+		r.cols = make([]*column, len(header))
+		for i, name := range header {
+			r.cols[i] = columnsByName[name]
+		}
+	}
+
+	fields, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	ta := &tradingview.TradingView{}
+	v := reflect.ValueOf(ta).Elem()
+	for i, text := range fields {
+		c := r.cols[i]
+		if c == nil {
+			continue
+		}
+		if err := parseValue(v.FieldByIndex(c.index), text); err != nil {
+			line, _ := r.r.FieldPos(i)
+			return nil, fmt.Errorf("export: line %d, column %s: %w", line, c.name, err)
+		}
+	}
+	return ta, nil
+}
+
+// ReadCSV reads all results from r.
+func ReadCSV(r io.Reader) ([]*tradingview.TradingView, error) {
+	cr := NewCSVReader(r)
+	var results []*tradingview.TradingView
+	for {
+		ta, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return results, nil
+		}
+		if err != nil {
+			return results, err
+		}
+		results = append(results, ta)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+func TestCSV_RoundTrip(t *testing.T) {
+	results := []*tradingview.TradingView{sample("BINANCE:BTCUSDT", 100), sample("BINANCE:ETHUSDT", 3500.25)}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, results); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	header, _, _ := strings.Cut(buf.String(), "\n")
+	if header != strings.Join(Columns(), ",") {
+		t.Fatalf("unexpected header: %s", header)
+	}
+
+	got, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != len(results) {
+		t.Fatalf("expected %d results, got %d", len(results), len(got))
+	}
+	for i, want := range results {
+		// CSV does not carry the raw field maps.
+		want.Raw, want.Strings, want.Missing = nil, nil, nil
+		if !reflect.DeepEqual(got[i], want) {
+			t.Fatalf("result %d mismatch:\n got %+v\nwant %+v", i, *got[i], *want)
+		}
+	}
+}
+
+func TestCSV_SelectedColumns(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, []*tradingview.TradingView{sample("BINANCE:BTCUSDT", 100)}, "symbol", "price.close", "osc.rsi"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := "symbol,price.close,osc.rsi.value,osc.rsi.signal\nBINANCE:BTCUSDT,100,25,BUY\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected CSV:\n%s", buf.String())
+	}
+
+	got, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	ta := got[0]
+	if ta.Symbol != "BINANCE:BTCUSDT" || ta.Value.Prices.Close != 100 || ta.Value.Oscillators.RSI != 25 || ta.Recommend.Oscillators.RSI != tradingview.SignalBuy {
+		t.Fatalf("unexpected result: %+v", ta)
+	}
+}
+
+func TestCSV_HeaderOnly(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, nil, "symbol", "price.close"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if buf.String() != "symbol,price.close\n" {
+		t.Fatalf("unexpected CSV: %q", buf.String())
+	}
+}
+
+func TestCSV_NilResult(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, []*tradingview.TradingView{sample("BINANCE:BTCUSDT", 100), nil}, "symbol")
+	if !errors.Is(err, ErrNilResult) || !strings.Contains(err.Error(), "result 1") {
+		t.Fatalf("expected ErrNilResult for result 1, got %v", err)
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	input := "symbol,comment,osc.rsi.signal,price.close\n" +
+		"BINANCE:BTCUSDT,ignored,strong buy,\n" +
+		"BINANCE:ETHUSDT,,SELL,oops\n"
+
+	r := NewCSVReader(strings.NewReader(input))
+	ta, err := r.Read()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ta.Symbol != "BINANCE:BTCUSDT" || ta.Recommend.Oscillators.RSI != tradingview.SignalStrongBuy || ta.Value.Prices.Close != 0 {
+		t.Fatalf("unexpected result: %+v", ta)
+	}
+
+	_, err = r.Read()
+	if err == nil || !strings.Contains(err.Error(), "line 3, column price.close") {
+		t.Fatalf("expected a parse error for line 3, got %v", err)
+	}
+}
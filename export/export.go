@@ -0,0 +1,309 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package export writes TradingView results as CSV and newline-delimited
+// JSON, and reads them back.
+//
+// CSV files have one row per result and one column per value, named after
+// the structs that hold it: the symbol, interval and fetched_at metadata,
+// then for each indicator its raw value and signal, such as osc.rsi.value
+// and osc.rsi.signal, indicators with several values by their parts, such
+// as osc.adx.plus_di, and finally pivot levels and prices, such as
+// pivot.classic.r1 and price.close. Column names come from the json struct
+// tags of tradingview.Values and tradingview.Recommendations, with a few
+// groups shortened, so new indicators get columns automatically; Columns
+// lists them all.
+//
+// NDJSON files have one JSON object per line: the JSON encoding of
+// tradingview.TradingView, or, when columns are selected, a flat object
+// keyed by column name.
+//
+// Columns can be selected and ordered by name or by prefix: "osc.rsi"
+// selects osc.rsi.value and osc.rsi.signal, and "pivot" every pivot level.
+// CSV files do not carry the Raw, Strings and Missing fields of a result.
+package export
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+var (
+	// ErrUnknownColumn reports a selected column that matches no column
+	// name.
+	ErrUnknownColumn = errors.New("export: unknown column")
+	// ErrNilResult reports a nil *tradingview.TradingView passed for
+	// writing, such as the entry of a symbol that failed to fetch.
+	ErrNilResult = errors.New("export: nil result")
+)
+
+// column is a flattened TradingView field.
+type column struct {
+	name  string
+	index []int // index sequence into tradingview.TradingView
+}
+
+var (
+	signalType    = reflect.TypeFor[tradingview.Signal]()
+	allColumns    = buildColumns()
+	columnsByName = func() map[string]*column {
+		byName := make(map[string]*column, len(allColumns))
+		for i := range allColumns {
+			byName[allColumns[i].name] = &allColumns[i]
+		}
+		return byName
+	}()
+)
+
+// Columns returns the names of all columns in their default order.
+func Columns() []string {
+	names := make([]string, len(allColumns))
+	for i, c := range allColumns {
+		names[i] = c.name
+	}
+	return names
+}
+
+// SelectColumns expands names, each a column name or a prefix of column
+// names, into column names in the order given. No names selects every
+// column. Names matching no column are reported with ErrUnknownColumn.
+func SelectColumns(names ...string) ([]string, error) {
+	cols, err := selectColumns(names)
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]string, len(cols))
+	for i, c := range cols {
+		selected[i] = c.name
+	}
+	return selected, nil
+}
+
+// Record returns the CSV text of the named columns of ta, which are
+// expanded as by SelectColumns. A nil ta is reported with ErrNilResult.
+func Record(ta *tradingview.TradingView, names ...string) ([]string, error) {
+	if ta == nil {
+		return nil, ErrNilResult
+	}
+	cols, err := selectColumns(names)
+	if err != nil {
+		return nil, err
+	}
+	return record(ta, cols)
+}
+
+func selectColumns(names []string) ([]*column, error) {
+	if len(names) == 0 {
+		cols := make([]*column, len(allColumns))
+		for i := range allColumns {
+			cols[i] = &allColumns[i]
+		}
+		return cols, nil
+	}
+
+	var cols []*column
+	for _, name := range names {
+		matched := false
+		for i := range allColumns {
+			c := &allColumns[i]
+			if c.name == name || strings.HasPrefix(c.name, name+".") {
+				matched = true
+				if !slices.Contains(cols, c) {
+					cols = append(cols, c)
+				}
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownColumn, name)
+		}
+	}
+	return cols, nil
+}
+
+// columnNames renames the fields whose json names make poor column names,
+// keyed by their json path in tradingview.Values.
+var columnNames = map[string]string{
+	"oscillators":             "osc",
+	"moving_averages":         "ma",
+	"pivots":                  "pivot",
+	"prices":                  "price",
+	"oscillators.macd.macd":   "value",
+	"oscillators.macd.signal": "signal_line",
+}
+
+// buildColumns derives the columns from the tradingview.TradingView type.
+func buildColumns() []column {
+	t := reflect.TypeFor[tradingview.TradingView]()
+	var cols []column
+	for _, name := range []string{"Symbol", "Interval", "FetchedAt"} {
+		f, _ := t.FieldByName(name)
+		cols = append(cols, column{name: fieldName(f), index: f.Index})
+	}
+
+	rec, _ := t.FieldByName("Recommend")
+	val, _ := t.FieldByName("Value")
+	matched := make(map[string]bool)
+	for i := range val.Type.NumField() {
+		group := val.Type.Field(i)
+		path := jsonName(group)
+		prefix := columnName(path, group)
+		index := []int{val.Index[0], i}
+		recGroup, ok := fieldByJSONName(rec.Type, group)
+		if !ok {
+			cols = appendNested(cols, prefix, path, group.Type, index)
+			continue
+		}
+		matched[recGroup.Name] = true
+		cols = appendIndicators(cols, prefix, path, group.Type, index, recGroup.Type, []int{rec.Index[0], recGroup.Index[0]})
+	}
+	for i := range rec.Type.NumField() {
+		if group := rec.Type.Field(i); !matched[group.Name] {
+			path := jsonName(group)
+			cols = appendIndicators(cols, columnName(path, group), path, nil, nil, group.Type, []int{rec.Index[0], i})
+		}
+	}
+	return cols
+}
+
+// appendIndicators appends the columns of a group of indicators whose raw
+// values are in the struct type values at valueIndex and json path path,
+// and whose signals are in the struct type signals at signalIndex. Values
+// may be nil.
+func appendIndicators(cols []column, prefix, path string, values reflect.Type, valueIndex []int, signals reflect.Type, signalIndex []int) []column {
+	matched := make(map[string]bool)
+	if values != nil {
+		for i := range values.NumField() {
+			f := values.Field(i)
+			fieldPath := path + "." + jsonName(f)
+			name := prefix + "." + columnName(fieldPath, f)
+			index := append(slices.Clone(valueIndex), i)
+			if f.Type.Kind() == reflect.Struct {
+				cols = appendNested(cols, name, fieldPath, f.Type, index)
+			} else {
+				cols = append(cols, column{name: name + ".value", index: index})
+			}
+			if s, ok := fieldByJSONName(signals, f); ok {
+				matched[s.Name] = true
+				cols = append(cols, column{name: name + ".signal", index: append(slices.Clone(signalIndex), s.Index[0])})
+			}
+		}
+	}
+	for i := range signals.NumField() {
+		if f := signals.Field(i); !matched[f.Name] {
+			name := prefix + "." + fieldName(f) + ".signal"
+			cols = append(cols, column{name: name, index: append(slices.Clone(signalIndex), i)})
+		}
+	}
+	return cols
+}
+
+// appendNested appends a column for each field of struct type t, whose
+// index sequence is index and json path is path, recursing into nested
+// structs.
+func appendNested(cols []column, prefix, path string, t reflect.Type, index []int) []column {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		fieldPath := path + "." + jsonName(f)
+		name := prefix + "." + columnName(fieldPath, f)
+		fieldIndex := append(slices.Clone(index), i)
+		if f.Type.Kind() == reflect.Struct {
+			cols = appendNested(cols, name, fieldPath, f.Type, fieldIndex)
+		} else {
+			cols = append(cols, column{name: name, index: fieldIndex})
+		}
+	}
+	return cols
+}
+
+// columnName returns the column name of f at json path path: its name in
+// columnNames, or its fieldName.
+func columnName(path string, f reflect.StructField) string {
+	if name, ok := columnNames[path]; ok {
+		return name
+	}
+	return fieldName(f)
+}
+
+// fieldName returns the json name of f, or its lower-cased Go name.
+func fieldName(f reflect.StructField) string {
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return strings.ToLower(f.Name)
+}
+
+// fieldByJSONName returns the field of struct type t with the same json
+// name as f.
+func fieldByJSONName(t reflect.Type, f reflect.StructField) (reflect.StructField, bool) {
+	want := jsonName(f)
+	for i := range t.NumField() {
+		if g := t.Field(i); jsonName(g) == want {
+			return g, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func jsonName(f reflect.StructField) string {
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return f.Name
+}
+
+// record returns the text of cols in ta.
+func record(ta *tradingview.TradingView, cols []*column) ([]string, error) {
+	v := reflect.ValueOf(ta).Elem()
+	fields := make([]string, len(cols))
+	for i, c := range cols {
+		text, err := formatValue(v.FieldByIndex(c.index))
+		if err != nil {
+			return nil, fmt.Errorf("export: column %s: %w", c.name, err)
+		}
+		fields[i] = text
+	}
+	return fields, nil
+}
+
+// formatValue returns the text of v. Zero times are empty.
+func formatValue(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Float64 {
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	}
+	if v.Kind() == reflect.Struct && v.IsZero() {
+		return "", nil
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err
+	}
+	return v.String(), nil
+}
+
+// parseValue stores text in v. Empty text leaves v unchanged.
+func parseValue(v reflect.Value, text string) error {
+	if text == "" {
+		return nil
+	}
+	if v.Kind() == reflect.Float64 {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+		return nil
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(text))
+	}
+	v.SetString(text)
+	return nil
+}
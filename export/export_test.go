@@ -0,0 +1,111 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// sample returns a result with values in every kind of column.
+func sample(symbol string, close float64) *tradingview.TradingView {
+	ta := tradingview.FromFields(map[string]float64{
+		"Recommend.All":      0.6,
+		"Recommend.Other":    0.2,
+		"Recommend.MA":       1,
+		"RSI":                25,
+		"RSI[1]":             20,
+		"ADX":                30,
+		"ADX+DI":             25,
+		"ADX-DI":             10,
+		"ADX+DI[1]":          20,
+		"ADX-DI[1]":          15,
+		"MACD.macd":          1.5,
+		"MACD.signal":        1.25,
+		"SMA200":             90,
+		"close":              close,
+		"Pivot.M.Classic.R1": 110.5,
+	})
+	ta.Symbol = symbol
+	ta.Interval = tradingview.Interval4Hour
+	ta.FetchedAt = time.Date(2026, 1, 2, 15, 4, 5, 6, time.UTC)
+	return ta
+}
+
+func TestColumns(t *testing.T) {
+	columns := Columns()
+	if !slices.Equal(columns[:3], []string{"symbol", "interval", "fetched_at"}) {
+		t.Fatalf("unexpected metadata columns: %v", columns[:3])
+	}
+	for _, name := range []string{
+		"global.summary.signal",
+		"osc.rsi.value",
+		"osc.rsi.signal",
+		"osc.adx.plus_di",
+		"osc.macd.value",
+		"osc.macd.signal_line",
+		"osc.macd.signal",
+		"ma.sma200.value",
+		"ma.hull_ma.signal",
+		"pivot.classic.r1",
+		"price.close",
+	} {
+		if !slices.Contains(columns, name) {
+			t.Errorf("missing column %s", name)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, name := range columns {
+		if seen[name] {
+			t.Errorf("duplicate column %s", name)
+		}
+		seen[name] = true
+	}
+}
+
+func TestSelectColumns(t *testing.T) {
+	got, err := SelectColumns("price.close", "osc.rsi", "symbol", "price.close")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := []string{"price.close", "osc.rsi.value", "osc.rsi.signal", "symbol"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("SelectColumns = %v, want %v", got, want)
+	}
+
+	// A prefix matches whole name segments only.
+	if _, err := SelectColumns("osc.rs"); !errors.Is(err, ErrUnknownColumn) {
+		t.Fatalf("expected ErrUnknownColumn, got %v", err)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	got, err := Record(sample("BINANCE:BTCUSDT", 100), "symbol", "interval", "fetched_at", "osc.rsi", "osc.macd", "pivot.classic.r1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := []string{
+		"BINANCE:BTCUSDT", "240", "2026-01-02T15:04:05.000000006Z",
+		"25", "BUY",
+		"1.5", "1.25", "BUY",
+		"110.5",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Record = %v, want %v", got, want)
+	}
+
+	if _, err := Record(nil, "symbol"); !errors.Is(err, ErrNilResult) {
+		t.Fatalf("expected ErrNilResult, got %v", err)
+	}
+
+	got, _ = Record(&tradingview.TradingView{}, "symbol", "fetched_at", "price.close")
+	if !slices.Equal(got, []string{"", "", "0"}) {
+		t.Fatalf("unexpected zero record: %q", got)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// NDJSONWriter writes TradingView results as newline-delimited JSON.
+type NDJSONWriter struct {
+	w    io.Writer
+	cols []*column // nil writes the full JSON encoding
+}
+
+// NewNDJSONWriter returns an NDJSONWriter writing to w. Without columns it
+// writes the JSON encoding of each result; with columns, selected as by
+// SelectColumns, it writes flat objects keyed by column name in that order.
+func NewNDJSONWriter(w io.Writer, columns ...string) (*NDJSONWriter, error) {
+	nw := &NDJSONWriter{w: w}
+	if len(columns) > 0 {
+		cols, err := selectColumns(columns)
+		if err != nil {
+			return nil, err
+		}
+		nw.cols = cols
+	}
+	return nw, nil
+}
+
+// Write writes ta as one line. A nil ta is reported with ErrNilResult.
+func (w *NDJSONWriter) Write(ta *tradingview.TradingView) error {
+	if ta == nil {
+		return ErrNilResult
+	}
+	var line []byte
+	var err error
+	if w.cols == nil {
+		line, err = json.Marshal(ta)
+	} else {
+		line, err = flatJSON(ta, w.cols)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(append(line, '\n'))
+	return err
+}
+
+// flatJSON encodes cols of ta as a JSON object. Zero times are left out.
+func flatJSON(ta *tradingview.TradingView, cols []*column) ([]byte, error) {
+	v := reflect.ValueOf(ta).Elem()
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for _, c := range cols {
+		field := v.FieldByIndex(c.index)
+		if field.Kind() == reflect.Struct && field.IsZero() {
+			continue
+		}
+		value, err := json.Marshal(field.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("export: column %s: %w", c.name, err)
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(c.name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// WriteNDJSON writes results to w as newline-delimited JSON with the
+// selected columns. A nil result stops the output with an error naming its
+// index.
+func WriteNDJSON(w io.Writer, results []*tradingview.TradingView, columns ...string) error {
+	nw, err := NewNDJSONWriter(w, columns...)
+	if err != nil {
+		return err
+	}
+	for i, ta := range results {
+		if err := nw.Write(ta); err != nil {
+			return fmt.Errorf("result %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// NDJSONReader reads TradingView results written by an NDJSONWriter, in
+// either form. Unknown keys of flat objects are ignored.
+type NDJSONReader struct {
+	d *json.Decoder
+}
+
+// NewNDJSONReader returns an NDJSONReader reading from r.
+func NewNDJSONReader(r io.Reader) *NDJSONReader {
+	return &NDJSONReader{d: json.NewDecoder(r)}
+}
+
+// Read reads the next result. It returns io.EOF when there are no more.
+func (r *NDJSONReader) Read() (*tradingview.TradingView, error) {
+	var object map[string]json.RawMessage
+	if err := r.d.Decode(&object); err != nil {
+		return nil, err
+	}
+
+	ta := &tradingview.TradingView{}
+	_, hasRecommend := object["recommend"]
+	_, hasValue := object["value"]
+	if hasRecommend || hasValue {
+		data, _ := json.Marshal(object)
+		if err := json.Unmarshal(data, ta); err != nil {
+			return nil, fmt.Errorf("export: %w", err)
+		}
+		return ta, nil
+	}
+
+	v := reflect.ValueOf(ta).Elem()
+	for name, raw := range object {
+		c := columnsByName[name]
+		if c == nil {
+			continue
+		}
+		if err := json.Unmarshal(raw, v.FieldByIndex(c.index).Addr().Interface()); err != nil {
+			return nil, fmt.Errorf("export: column %s: %w", name, err)
+		}
+	}
+	return ta, nil
+}
+
+// ReadNDJSON reads all results from r.
+func ReadNDJSON(r io.Reader) ([]*tradingview.TradingView, error) {
+	nr := NewNDJSONReader(r)
+	var results []*tradingview.TradingView
+	for {
+		ta, err := nr.Read()
+		if errors.Is(err, io.EOF) {
+			return results, nil
+		}
+		if err != nil {
+			return results, err
+		}
+		results = append(results, ta)
+	}
+}
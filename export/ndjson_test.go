@@ -0,0 +1,90 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+func TestNDJSON_RoundTrip(t *testing.T) {
+	results := []*tradingview.TradingView{sample("BINANCE:BTCUSDT", 100), sample("BINANCE:ETHUSDT", 3500.25)}
+	results[0].Strings = map[string]string{"description": "Bitcoin / TetherUS"}
+
+	var buf bytes.Buffer
+	if err := WriteNDJSON(&buf, results); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if n := strings.Count(buf.String(), "\n"); n != len(results) {
+		t.Fatalf("expected %d lines, got %d", len(results), n)
+	}
+
+	got, err := ReadNDJSON(&buf)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(got, results) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", got, results)
+	}
+}
+
+func TestNDJSON_SelectedColumns(t *testing.T) {
+	ta := sample("BINANCE:BTCUSDT", 100)
+
+	var buf bytes.Buffer
+	if err := WriteNDJSON(&buf, []*tradingview.TradingView{ta, {}}, "symbol", "price.close", "osc.rsi", "fetched_at"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := `{"symbol":"BINANCE:BTCUSDT","price.close":100,"osc.rsi.value":25,"osc.rsi.signal":"BUY","fetched_at":"2026-01-02T15:04:05.000000006Z"}` + "\n" +
+		`{"symbol":"","price.close":0,"osc.rsi.value":0,"osc.rsi.signal":"NEUTRAL"}` + "\n" // zero times are left out
+	if buf.String() != want {
+		t.Fatalf("unexpected NDJSON:\n%s", buf.String())
+	}
+
+	got, err := ReadNDJSON(&buf)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(got))
+	}
+	first := got[0]
+	if first.Symbol != "BINANCE:BTCUSDT" || first.Value.Prices.Close != 100 || first.Recommend.Oscillators.RSI != tradingview.SignalBuy || !first.FetchedAt.Equal(ta.FetchedAt) {
+		t.Fatalf("unexpected result: %+v", first)
+	}
+}
+
+func TestNDJSON_NilResult(t *testing.T) {
+	for _, columns := range [][]string{nil, {"symbol"}} {
+		var buf bytes.Buffer
+		err := WriteNDJSON(&buf, []*tradingview.TradingView{nil}, columns...)
+		if !errors.Is(err, ErrNilResult) || !strings.Contains(err.Error(), "result 0") || buf.Len() != 0 {
+			t.Fatalf("columns %v: expected ErrNilResult for result 0, got %v and %q", columns, err, buf.String())
+		}
+	}
+}
+
+func TestNDJSONReader(t *testing.T) {
+	input := `{"symbol":"BINANCE:BTCUSDT","comment":"ignored","osc.rsi.signal":"strong buy"}` + "\n\n" +
+		`{"price.close":"oops"}` + "\n"
+
+	r := NewNDJSONReader(strings.NewReader(input))
+	ta, err := r.Read()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ta.Symbol != "BINANCE:BTCUSDT" || ta.Recommend.Oscillators.RSI != tradingview.SignalStrongBuy {
+		t.Fatalf("unexpected result: %+v", ta)
+	}
+
+	if _, err := r.Read(); err == nil || !strings.Contains(err.Error(), "column price.close") {
+		t.Fatalf("expected a decode error for price.close, got %v", err)
+	}
+}
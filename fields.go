@@ -0,0 +1,44 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import "maps"
+
+// FromFields returns a TradingView rated from scanner fields without
+// interval suffix, such as "RSI", "RSI[1]" or "Pivot.M.Classic.R1", as Get
+// would rate a response holding them. It lets values computed elsewhere, for
+// example by the indicators package or from another data source, be rated
+// with DefaultRules; use Recompute on the result for other rules.
+//
+// Fields that are not given are listed in Missing. The aggregate
+// "Recommend.Other", "Recommend.MA" and "Recommend.All" values, when not
+// given, are computed as TradingView does: the Counts.Score of the
+// oscillator and moving-average signals, and the mean of those two. The
+// Symbol, Interval and FetchedAt of the result are empty, and fields is not
+// modified.
+func FromFields(fields map[string]float64) *TradingView {
+	ta := &TradingView{}
+	ta.populate(fields, "", nil)
+
+	_, hasOther := fields["Recommend.Other"]
+	_, hasMA := fields["Recommend.MA"]
+	_, hasAll := fields["Recommend.All"]
+	if hasOther && hasMA && hasAll {
+		return ta
+	}
+
+	fields = maps.Clone(fields)
+	if !hasOther {
+		fields["Recommend.Other"] = ta.Recommend.Oscillators.Counts().Score()
+	}
+	if !hasMA {
+		fields["Recommend.MA"] = ta.Recommend.MovingAverages.Counts().Score()
+	}
+	if !hasAll {
+		fields["Recommend.All"] = (fields["Recommend.Other"] + fields["Recommend.MA"]) / 2
+	}
+	ta.populate(fields, "", nil)
+	return ta
+}
@@ -0,0 +1,72 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFromFields(t *testing.T) {
+	ta := FromFields(map[string]float64{
+		"RSI":      25,
+		"RSI[1]":   20,
+		"EMA10":    90,
+		"SMA10":    110,
+		"EMA20":    95,
+		"close":    100,
+		"Rec.UO":   1,
+		"Rec.WR":   -1,
+		"Mom":      1,
+		"Mom[1]":   1,
+		"Rec.VWMA": 1,
+	})
+
+	if ta.Recommend.Oscillators.RSI != SignalBuy || ta.Recommend.MovingAverages.SMA10 != SignalSell {
+		t.Fatalf("unexpected signals: %+v", ta.Recommend)
+	}
+	if !ta.IsSet("Recommend.All") || slices.Contains(ta.Missing, "Recommend.MA") {
+		t.Fatalf("expected computed aggregates to be set, missing %v", ta.Missing)
+	}
+
+	// Oscillators: RSI and UO buy, WR sells, Mom is neutral.
+	if want := 1.0 / 11; ta.Value.Global.Oscillators != want {
+		t.Fatalf("Oscillators = %v, want %v", ta.Value.Global.Oscillators, want)
+	}
+	// Moving averages: EMA10, EMA20 and VWMA buy, SMA10 sells.
+	if want := 2.0 / 15; ta.Value.Global.MA != want {
+		t.Fatalf("MA = %v, want %v", ta.Value.Global.MA, want)
+	}
+	if want := (1.0/11 + 2.0/15) / 2; ta.Value.Global.Summary != want {
+		t.Fatalf("Summary = %v, want %v", ta.Value.Global.Summary, want)
+	}
+	if ta.Recommend.Global.Summary != SignalBuy {
+		t.Fatalf("Summary signal = %v, want BUY", ta.Recommend.Global.Summary)
+	}
+}
+
+func TestFromFieldsKeepsAggregates(t *testing.T) {
+	fields := map[string]float64{
+		"Recommend.All":   -0.7,
+		"Recommend.Other": 0,
+		"Recommend.MA":    0.3,
+		"RSI":             25,
+		"RSI[1]":          20,
+	}
+	ta := FromFields(fields)
+
+	if ta.Value.Global.Summary != -0.7 || ta.Recommend.Global.Summary != SignalStrongSell {
+		t.Fatalf("unexpected summary: %v, %v", ta.Value.Global.Summary, ta.Recommend.Global.Summary)
+	}
+	if ta.Recommend.Global.MA != SignalBuy {
+		t.Fatalf("unexpected MA signal: %v", ta.Recommend.Global.MA)
+	}
+	if ta.Raw["RSI"] != 25 || !slices.Contains(ta.Missing, "EMA10") || slices.Contains(ta.Missing, "RSI") {
+		t.Fatalf("unexpected Raw or Missing: %v %v", ta.Raw, ta.Missing)
+	}
+	if len(fields) != 5 {
+		t.Fatalf("FromFields modified its argument: %v", fields)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FieldSet lists scanner fields to request in addition to the ones needed
+// for recommendations. Their values are stored in TradingView.Raw or, for
+// string values, TradingView.Strings, and fields TradingView does not
+// return are listed in TradingView.Missing.
+type FieldSet struct {
+	// Interval lists fields that depend on the interval, such as "volume"
+	// or "change". They are requested with the interval suffix.
+	Interval []string
+	// Static lists fields that do not depend on the interval, such as
+	// "description" or "Volatility.D". They are requested as given.
+	Static []string
+}
+
+// defaultFields is the set of fields requested for every interval, without
+// interval suffix.
+var defaultFields = func() map[string]bool {
+	fields := make(map[string]bool)
+	for _, field := range fieldsForInterval("") {
+		fields[field] = true
+	}
+	return fields
+}()
+
+// columns returns the columns of the interval fields of s at dataInterval,
+// leaving out default fields.
+func (s FieldSet) columns(dataInterval string) []string {
+	var columns []string
+	for _, field := range s.Interval {
+		if !defaultFields[field] {
+			columns = append(columns, field+dataInterval)
+		}
+	}
+	return columns
+}
+
+// static returns the static fields of s, leaving out default fields.
+func (s FieldSet) static() []string {
+	var static []string
+	for _, field := range s.Static {
+		if !defaultFields[field] {
+			static = append(static, field)
+		}
+	}
+	return static
+}
+
+// key returns a string identifying the fields of s, for cache keys.
+func (s FieldSet) key() string {
+	if len(s.Interval) == 0 && len(s.Static) == 0 {
+		return ""
+	}
+	return strings.Join(s.Interval, ",") + ";" + strings.Join(s.Static, ",")
+}
+
+// columns returns the scanner columns requested by c at dataInterval.
+func (c *Client) columns(dataInterval string) []string {
+	extra := c.extra()
+	return slices.Concat(fieldsForInterval(dataInterval), extra.columns(dataInterval), extra.static())
+}
+
+func (c *Client) extra() FieldSet {
+	if c == nil {
+		return FieldSet{}
+	}
+	return c.Extra
+}
+
+// populateExtra stores the values of the fields of extra in ta. It must be
+// called after populate.
+func (ta *TradingView) populateExtra(extra FieldSet, responseMap map[string]float64, strs map[string]string, dataInterval string) {
+	add := func(field, column string) {
+		if defaultFields[field] {
+			return
+		}
+		if v, ok := responseMap[column]; ok {
+			ta.Raw[field] = v
+			return
+		}
+		if s, ok := strs[column]; ok {
+			if ta.Strings == nil {
+				ta.Strings = make(map[string]string)
+			}
+			ta.Strings[field] = s
+			return
+		}
+		if !slices.Contains(ta.Missing, field) {
+			ta.Missing = append(ta.Missing, field)
+		}
+	}
+	for _, field := range extra.Interval {
+		add(field, field+dataInterval)
+	}
+	for _, field := range extra.Static {
+		add(field, field)
+	}
+}
+
+// decodeValue decodes raw, the value of column, into values. String values
+// of fields other than the default fields go into strs, which is allocated
+// as needed. Null values are left out.
+func decodeValue(column string, raw json.RawMessage, values map[string]float64, strs *map[string]string) error {
+	if raw == nil || string(raw) == "null" {
+		return nil
+	}
+	field, _, _ := strings.Cut(column, "|")
+	if raw[0] == '"' && !defaultFields[field] {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return newDecodeError(raw, fmt.Errorf("column %s: %w", column, err))
+		}
+		if *strs == nil {
+			*strs = make(map[string]string)
+		}
+		(*strs)[column] = s
+		return nil
+	}
+
+	var v float64
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return newDecodeError(raw, fmt.Errorf("column %s: %w", column, err))
+	}
+	values[column] = v
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var testExtra = FieldSet{
+	Interval: []string{"volume", "change", "close"},
+	Static:   []string{"description", "Volatility.D", "sector"},
+}
+
+func TestClient_GetExtraFields(t *testing.T) {
+	var fields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields = strings.Split(r.URL.Query().Get("fields"), ",")
+		_, _ = w.Write([]byte(`{
+			"close|60": 100,
+			"volume|60": 1234.5,
+			"change|60": null,
+			"description": "Bitcoin / TetherUS",
+			"Volatility.D": 2.5
+		}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Extra:      testExtra,
+	}
+
+	var ta TradingView
+	if err := client.Get(&ta, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, field := range []string{"volume|60", "change|60", "description", "Volatility.D", "sector"} {
+		if !slices.Contains(fields, field) {
+			t.Fatalf("expected field %s to be requested, got %v", field, fields)
+		}
+	}
+	if want := len(fieldsForInterval("")) + 5; len(fields) != want {
+		t.Fatalf("requested %d fields, want %d", len(fields), want)
+	}
+
+	if ta.Raw["volume"] != 1234.5 || ta.Raw["Volatility.D"] != 2.5 || ta.Raw["close"] != 100 {
+		t.Fatalf("unexpected raw values: %v", ta.Raw)
+	}
+	if ta.Strings["description"] != "Bitcoin / TetherUS" || !ta.IsSet("description") {
+		t.Fatalf("unexpected string values: %v", ta.Strings)
+	}
+	if ta.Value.Prices.Close != 100 {
+		t.Fatalf("Close = %v, want 100", ta.Value.Prices.Close)
+	}
+	for _, field := range []string{"change", "sector"} {
+		if ta.IsSet(field) || !slices.Contains(ta.Missing, field) {
+			t.Fatalf("expected %s to be missing, got %v", field, ta.Missing)
+		}
+	}
+}
+
+func TestClient_GetManyExtraFields(t *testing.T) {
+	server := httptest.NewServer(scanHandler(t, map[string]map[string]any{
+		"NASDAQ:AAPL": {"close|60": 200, "volume|60": 10, "description": "Apple Inc."},
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+		Extra:       FieldSet{Interval: []string{"volume"}, Static: []string{"description"}},
+	}
+
+	results, err := client.GetMany(context.Background(), []string{"NASDAQ:AAPL"}, Interval1Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	aapl := results["NASDAQ:AAPL"]
+	if aapl.Raw["volume"] != 10 || aapl.Strings["description"] != "Apple Inc." {
+		t.Fatalf("unexpected extra values: %v %v", aapl.Raw, aapl.Strings)
+	}
+}
+
+func TestClient_GetIntervalsExtraFields(t *testing.T) {
+	var fields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields = strings.Split(r.URL.Query().Get("fields"), ",")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"volume|15":   1,
+			"volume|60":   4,
+			"description": "Bitcoin",
+		})
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Extra:      FieldSet{Interval: []string{"volume"}, Static: []string{"description"}},
+	}
+
+	results, err := client.GetIntervals("BINANCE:BTCUSDT", Interval15Min, Interval1Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if want := 2*len(fieldsForInterval("")) + 3; len(fields) != want {
+		t.Fatalf("requested %d fields, want %d: %v", len(fields), want, fields)
+	}
+	if results[Interval15Min].Raw["volume"] != 1 || results[Interval1Hour].Raw["volume"] != 4 {
+		t.Fatalf("unexpected volumes: %v %v", results[Interval15Min].Raw, results[Interval1Hour].Raw)
+	}
+	if results[Interval1Hour].Strings["description"] != "Bitcoin" {
+		t.Fatalf("unexpected strings: %v", results[Interval1Hour].Strings)
+	}
+}
+
+func TestClient_GetCacheExtraFields(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"close": 1, "volume": 2}`))
+	}))
+	defer server.Close()
+
+	cache := &Cache{}
+	plain := Client{HTTPClient: server.Client(), BaseURL: server.URL, Cache: cache}
+	extra := plain
+	extra.Extra = FieldSet{Interval: []string{"volume"}}
+
+	var ta TradingView
+	for _, c := range []*Client{&plain, &extra, &plain, &extra} {
+		if err := c.Get(&ta, "BINANCE:BTCUSDT", Interval1Day); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if ta.Raw["volume"] != 2 {
+		t.Fatalf("expected cached extra value, got %v", ta.Raw)
+	}
+}
+
+func Test_decodeValue(t *testing.T) {
+	values := make(map[string]float64)
+	var strs map[string]string
+
+	for column, raw := range map[string]string{
+		"close|60":    "1.5",
+		"RSI":         "null",
+		"description": `"Bitcoin"`,
+		"name|60":     `"BTCUSDT"`,
+	} {
+		if err := decodeValue(column, json.RawMessage(raw), values, &strs); err != nil {
+			t.Fatalf("%s: expected no error, got %v", column, err)
+		}
+	}
+	if len(values) != 1 || values["close|60"] != 1.5 {
+		t.Fatalf("unexpected values: %v", values)
+	}
+	if len(strs) != 2 || strs["description"] != "Bitcoin" || strs["name|60"] != "BTCUSDT" {
+		t.Fatalf("unexpected strings: %v", strs)
+	}
+
+	var decodeErr *DecodeError
+	if err := decodeValue("close|60", json.RawMessage(`"oops"`), values, &strs); !errors.As(err, &decodeErr) {
+		t.Fatalf("expected *DecodeError for string in a default field, got %v", err)
+	}
+	if err := decodeValue("volume", json.RawMessage(`true`), values, &strs); !errors.As(err, &decodeErr) {
+		t.Fatalf("expected *DecodeError for bool value, got %v", err)
+	}
+}
+
+func TestTradingView_RecomputeKeepsExtraFields(t *testing.T) {
+	ta := FromFields(map[string]float64{"RSI": 25, "RSI[1]": 20})
+	ta.Raw["volume"] = 10
+	ta.Strings = map[string]string{"description": "Bitcoin"}
+	ta.Missing = append(ta.Missing, "change")
+
+	rules := DefaultRules()
+	rules.RSIOversold = 10
+	ta.Recompute(&rules)
+
+	if ta.Raw["volume"] != 10 || ta.Strings["description"] != "Bitcoin" || !slices.Contains(ta.Missing, "change") {
+		t.Fatalf("Recompute dropped extra fields: %v %v %v", ta.Raw, ta.Strings, ta.Missing)
+	}
+	if ta.Recommend.Oscillators.RSI != SignalNeutral {
+		t.Fatalf("RSI = %v, want %v", ta.Recommend.Oscillators.RSI, SignalNeutral)
+	}
+}
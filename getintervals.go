@@ -0,0 +1,75 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// GetIntervals fetches recommendations and raw indicator values for symbol at
+// each of intervals with a single request.
+//
+// The returned map is keyed by the intervals as given. Intervals that share a
+// data suffix, such as "" and Interval1Day, share the requested fields.
+// In strict mode, intervals with missing fields are still returned together
+// with an error joining one *MissingFieldsError per affected interval.
+func (c *Client) GetIntervals(symbol string, intervals ...Interval) (map[Interval]*TradingView, error) {
+	return c.GetIntervalsContext(context.Background(), symbol, intervals...)
+}
+
+// GetIntervalsContext is like GetIntervals but uses ctx for the request.
+func (c *Client) GetIntervalsContext(ctx context.Context, symbol string, intervals ...Interval) (map[Interval]*TradingView, error) {
+	symbol, err := normalizeSymbol(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[Interval]*TradingView, len(intervals))
+	if len(intervals) == 0 {
+		return results, nil
+	}
+
+	var fields []string
+	suffixes := make(map[Interval]string, len(intervals))
+	requested := make(map[string]bool, len(intervals))
+	for _, interval := range intervals {
+		dataInterval, err := c.intervalSuffix(interval)
+		if err != nil {
+			return nil, err
+		}
+		suffixes[interval] = dataInterval
+		if requested[dataInterval] {
+			continue
+		}
+		requested[dataInterval] = true
+		fields = append(fields, fieldsForInterval(dataInterval)...)
+		fields = append(fields, c.extra().columns(dataInterval)...)
+	}
+	fields = append(fields, c.extra().static()...)
+
+	responseMap, strs, err := c.getResponseMap(ctx, symbol, "", fields)
+	if err != nil {
+		return nil, err
+	}
+	fetchedAt := time.Now()
+
+	var errs []error
+	for _, interval := range intervals {
+		if _, ok := results[interval]; ok {
+			continue
+		}
+		ta := &TradingView{}
+		ta.populate(responseMap, suffixes[interval], c.rules())
+		ta.populateExtra(c.extra(), responseMap, strs, suffixes[interval])
+		ta.setSource(symbol, interval, fetchedAt)
+		results[interval] = ta
+		if err := c.checkMissing(symbol, interval, ta); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return results, errors.Join(errs...)
+}
@@ -0,0 +1,101 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetIntervals(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		fields := strings.Split(r.URL.Query().Get("fields"), ",")
+		for _, expected := range []string{"RSI|15", "RSI|60", "RSI", "close|15", "close|60", "close"} {
+			if !slices.Contains(fields, expected) {
+				http.Error(w, "missing expected field "+expected, http.StatusBadRequest)
+				return
+			}
+		}
+		if len(fields) != 3*len(fieldsForInterval("")) {
+			http.Error(w, "unexpected number of fields", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]float64{
+			"Recommend.All|15": 0.6,
+			"Recommend.All|60": -0.3,
+			"Recommend.All":    0,
+			"close|15":         101,
+			"close|60":         102,
+			"close":            103,
+		})
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	results, err := client.GetIntervals("BINANCE:BTCUSDT", Interval15Min, Interval1Hour, Interval1Day, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+
+	tests := []struct {
+		interval Interval
+		summary  Signal
+		close    float64
+	}{
+		{Interval15Min, SignalStrongBuy, 101},
+		{Interval1Hour, SignalSell, 102},
+		{Interval1Day, SignalNeutral, 103},
+		{"", SignalNeutral, 103},
+	}
+	for _, tt := range tests {
+		ta, ok := results[tt.interval]
+		if !ok {
+			t.Fatalf("missing result for interval %q", tt.interval)
+		}
+		if ta.Recommend.Global.Summary != tt.summary || ta.Value.Prices.Close != tt.close {
+			t.Fatalf("interval %q: unexpected result %+v", tt.interval, ta)
+		}
+		if ta.Symbol != "BINANCE:BTCUSDT" || ta.Interval != canonicalInterval(tt.interval) {
+			t.Fatalf("interval %q: unexpected source %q %q", tt.interval, ta.Symbol, ta.Interval)
+		}
+	}
+}
+
+func TestClient_GetIntervalsInvalidSymbol(t *testing.T) {
+	var client Client
+
+	if _, err := client.GetIntervals("BTCUSDT", Interval1Hour); !errors.Is(err, ErrInvalidSymbol) {
+		t.Fatalf("expected ErrInvalidSymbol, got %v", err)
+	}
+}
+
+func TestClient_GetIntervalsNoIntervals(t *testing.T) {
+	var client Client
+
+	results, err := client.GetIntervals("BINANCE:BTCUSDT")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected no results, got %v", results)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+// VWMA returns the volume-weighted moving average of the close of bars over
+// length bars.
+func VWMA(bars []Bar, length int) []float64 {
+	priceVolume := make([]float64, len(bars))
+	for i, b := range bars {
+		priceVolume[i] = b.Close * b.Volume
+	}
+	pv, volume := SMA(priceVolume, length), SMA(volumes(bars), length)
+	out := nanSeries(len(bars))
+	for i := range bars {
+		if volume[i] != 0 {
+			out[i] = pv[i] / volume[i]
+		}
+	}
+	return out
+}
+
+// Donchian returns the midpoint of the highest high and lowest low of bars
+// over length bars. The Ichimoku conversion line is Donchian(bars, 9) and
+// its base line is Donchian(bars, 26).
+func Donchian(bars []Bar, length int) []float64 {
+	high, low := Highest(highs(bars), length), Lowest(lows(bars), length)
+	out := make([]float64, len(bars))
+	for i := range out {
+		out[i] = (high[i] + low[i]) / 2
+	}
+	return out
+}
@@ -0,0 +1,26 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import "testing"
+
+func TestVWMA(t *testing.T) {
+	bars := []Bar{
+		{Close: 1, Volume: 1},
+		{Close: 2, Volume: 3},
+		{Close: 4, Volume: 0},
+		{Close: 4, Volume: 0},
+	}
+	assertSeries(t, "VWMA", VWMA(bars, 2), []float64{nan, 1.75, 2, nan})
+}
+
+func TestDonchian(t *testing.T) {
+	bars := []Bar{
+		{High: 4, Low: 2},
+		{High: 6, Low: 3},
+		{High: 5, Low: 1},
+	}
+	assertSeries(t, "Donchian", Donchian(bars, 2), []float64{nan, 4, 3.5})
+}
@@ -0,0 +1,231 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package indicators computes TradingView's technical indicators from OHLCV
+// bars, so that the signals returned by the scanner can be reproduced
+// offline, for example in backtests.
+//
+// The series functions, such as RSI and MACD, return one value per bar and
+// NaN for bars before the indicator has enough data. Fields computes the
+// latest value of every indicator under its scanner field name, and Compute
+// rates those values with the same rules tradingview.Client uses for live
+// data.
+//
+// The simple ratings that the scanner reports as "Rec.*" fields follow
+// TradingView's Technical Ratings: Stochastic RSI buys below 20 in a
+// downtrend when %K is above %D; Williams %R buys below -80 when rising; Bull
+// Bear Power buys in an uptrend when bear power is negative and rising;
+// Ultimate Oscillator buys above 70; Ichimoku buys when the close is above a
+// rising cloud and the base line, with the conversion line above the base
+// line; VWMA and Hull MA buy when below the close. Sell rules mirror the buy
+// rules. The trend is up when the close is above its 50-bar SMA.
+package indicators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// Bar is one period of price and volume data.
+type Bar struct {
+	Time   time.Time // Start of the period
+	Open   float64   // Opening price
+	High   float64   // Highest price
+	Low    float64   // Lowest price
+	Close  float64   // Closing price
+	Volume float64   // Traded volume
+}
+
+// ErrNoBars reports that no bars were given.
+var ErrNoBars = errors.New("indicators: no bars")
+
+// Compute returns a TradingView populated from the indicators of bars, which
+// must be ordered from oldest to newest. The latest bar is treated as
+// current.
+//
+// Indicators that need more bars than given are reported in
+// TradingView.Missing and rated neutral. EMA200 and SMA200 need 200 bars.
+// Pivot levels are computed from the previous calendar month in UTC, like
+// the scanner's "Pivot.M" fields, when bars have times and cover it.
+func Compute(bars []Bar) (*tradingview.TradingView, error) {
+	if len(bars) == 0 {
+		return nil, ErrNoBars
+	}
+	return tradingview.FromFields(Fields(bars)), nil
+}
+
+// Fields returns the latest indicator values of bars keyed by scanner field
+// name without interval suffix, such as "RSI", "RSI[1]" or "Rec.UO". Values
+// that cannot be computed from bars are omitted.
+func Fields(bars []Bar) map[string]float64 {
+	f := make(fields)
+	close := closes(bars)
+	trend := trendSeries(close)
+
+	rsi := RSI(close, 14)
+	f.set("RSI", rsi, 0)
+	f.set("RSI[1]", rsi, 1)
+
+	stochK, stochD := Stoch(bars, 14, 3, 3)
+	f.set("Stoch.K", stochK, 0)
+	f.set("Stoch.D", stochD, 0)
+	f.set("Stoch.K[1]", stochK, 1)
+	f.set("Stoch.D[1]", stochD, 1)
+
+	cci := CCI(bars, 20)
+	f.set("CCI20", cci, 0)
+	f.set("CCI20[1]", cci, 1)
+
+	plusDI, minusDI, adx := DMI(bars, 14, 14)
+	f.set("ADX", adx, 0)
+	f.set("ADX+DI", plusDI, 0)
+	f.set("ADX-DI", minusDI, 0)
+	f.set("ADX+DI[1]", plusDI, 1)
+	f.set("ADX-DI[1]", minusDI, 1)
+
+	ao := AO(bars)
+	f.set("AO", ao, 0)
+	f.set("AO[1]", ao, 1)
+	f.set("AO[2]", ao, 2)
+
+	mom := Change(close, 10)
+	f.set("Mom", mom, 0)
+	f.set("Mom[1]", mom, 1)
+
+	macd, signal := MACD(close, 12, 26, 9)
+	f.set("MACD.macd", macd, 0)
+	f.set("MACD.signal", signal, 0)
+
+	stochRSIK, stochRSID := StochRSI(close, 14, 14, 3, 3)
+	f.set("Stoch.RSI.K", stochRSIK, 0)
+	f.rate("Rec.Stoch.RSI", rateStochRSI(last(stochRSIK, 0), last(stochRSID, 0), last(trend, 0)))
+
+	wr := WilliamsR(bars, 14)
+	f.set("W.R", wr, 0)
+	f.rate("Rec.WR", rateWR(last(wr, 0), last(wr, 1)))
+
+	bull, bear := BullBearPower(bars, 13)
+	bbp := make([]float64, len(bars))
+	for i := range bbp {
+		bbp[i] = bull[i] + bear[i]
+	}
+	f.set("BBPower", bbp, 0)
+	f.rate("Rec.BBPower", rateBBPower(last(bull, 0), last(bull, 1), last(bear, 0), last(bear, 1), last(trend, 0)))
+
+	uo := UltimateOscillator(bars, 7, 14, 28)
+	f.set("UO", uo, 0)
+	f.rate("Rec.UO", rateUO(last(uo, 0)))
+
+	for _, length := range []int{10, 20, 30, 50, 100, 200} {
+		f.set(fmt.Sprintf("EMA%d", length), EMA(close, length), 0)
+		f.set(fmt.Sprintf("SMA%d", length), SMA(close, length), 0)
+	}
+
+	conversion, base := Donchian(bars, 9), Donchian(bars, 26)
+	leadA, leadB := make([]float64, len(bars)), Donchian(bars, 52)
+	for i := range leadA {
+		leadA[i] = (conversion[i] + base[i]) / 2
+	}
+	f.set("Ichimoku.BLine", base, 0)
+	f.rate("Rec.Ichimoku", rateIchimoku(last(conversion, 0), last(base, 0), last(leadA, 25), last(leadB, 25), last(close, 0)))
+
+	vwma := VWMA(bars, 20)
+	f.set("VWMA", vwma, 0)
+	f.rate("Rec.VWMA", rateMA(last(vwma, 0), last(close, 0)))
+
+	hull := HMA(close, 9)
+	f.set("HullMA9", hull, 0)
+	f.rate("Rec.HullMA9", rateMA(last(hull, 0), last(close, 0)))
+
+	if pivots, ok := monthlyPivots(bars); ok {
+		f.setPivots(pivots)
+	}
+
+	f.set("close", close, 0)
+	f.set("high", highs(bars), 0)
+	f.set("low", lows(bars), 0)
+	return f
+}
+
+// fields collects indicator values by scanner field name.
+type fields map[string]float64
+
+// set stores the value of series back bars before the latest, if it is
+// known.
+func (f fields) set(name string, series []float64, back int) {
+	if v := last(series, back); !math.IsNaN(v) {
+		f[name] = v
+	}
+}
+
+// setPivots stores the monthly pivot levels.
+func (f fields) setPivots(p tradingview.PivotValues) {
+	levels := map[string]tradingview.ClassicPivotLevels{
+		"Classic":   p.Classic,
+		"Fibonacci": tradingview.ClassicPivotLevels(p.Fibonacci),
+		"Camarilla": tradingview.ClassicPivotLevels(p.Camarilla),
+		"Woodie":    tradingview.ClassicPivotLevels(p.Woodie),
+	}
+	for system, l := range levels {
+		prefix := "Pivot.M." + system + "."
+		f[prefix+"Middle"] = l.Middle
+		f[prefix+"R1"], f[prefix+"R2"], f[prefix+"R3"] = l.R1, l.R2, l.R3
+		f[prefix+"S1"], f[prefix+"S2"], f[prefix+"S3"] = l.S1, l.S2, l.S3
+	}
+	f["Pivot.M.Demark.Middle"] = p.Demark.Middle
+	f["Pivot.M.Demark.R1"] = p.Demark.R1
+	f["Pivot.M.Demark.S1"] = p.Demark.S1
+}
+
+// rate stores a rating if it is known.
+func (f fields) rate(name string, rating float64) {
+	if !math.IsNaN(rating) {
+		f[name] = rating
+	}
+}
+
+// last returns the value of series back bars before the latest, or NaN.
+func last(series []float64, back int) float64 {
+	i := len(series) - 1 - back
+	if i < 0 {
+		return math.NaN()
+	}
+	return series[i]
+}
+
+func closes(bars []Bar) []float64 {
+	out := make([]float64, len(bars))
+	for i, b := range bars {
+		out[i] = b.Close
+	}
+	return out
+}
+
+func highs(bars []Bar) []float64 {
+	out := make([]float64, len(bars))
+	for i, b := range bars {
+		out[i] = b.High
+	}
+	return out
+}
+
+func lows(bars []Bar) []float64 {
+	out := make([]float64, len(bars))
+	for i, b := range bars {
+		out[i] = b.Low
+	}
+	return out
+}
+
+func volumes(bars []Bar) []float64 {
+	out := make([]float64, len(bars))
+	for i, b := range bars {
+		out[i] = b.Volume
+	}
+	return out
+}
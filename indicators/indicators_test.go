@@ -0,0 +1,154 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// trendBars returns n bars on a straight line with slope per bar and a
+// wave on top that makes the close fall now and then.
+func trendBars(n int, slope float64) []Bar {
+	bars := make([]Bar, n)
+	for i := range bars {
+		mid := 1000 + slope*float64(i) + 4*math.Sin(float64(i)/2)
+		bars[i] = Bar{Open: mid, High: mid + 1, Low: mid - 1, Close: mid + 0.5*slope, Volume: 100 + float64(i%7)}
+	}
+	return bars
+}
+
+func TestCompute(t *testing.T) {
+	bars := trendBars(300, 1)
+	ta, err := Compute(bars)
+	if err != nil {
+		t.Fatalf("Compute() error = %v", err)
+	}
+
+	for _, field := range ta.Missing {
+		if !strings.HasPrefix(field, "Pivot.") {
+			t.Errorf("unexpected missing field %q", field)
+		}
+	}
+
+	ma := ta.Recommend.MovingAverages
+	for name, signal := range map[string]tradingview.Signal{
+		"EMA10": ma.EMA10, "SMA200": ma.SMA200, "Ichimoku": ma.Ichimoku, "VWMA": ma.VWMA, "HullMA": ma.HullMA,
+	} {
+		if signal != tradingview.SignalBuy {
+			t.Errorf("%s = %v, want BUY in an uptrend", name, signal)
+		}
+	}
+	if ta.Recommend.Global.MA != tradingview.SignalStrongBuy {
+		t.Errorf("Global.MA = %v, want STRONG_BUY", ta.Recommend.Global.MA)
+	}
+	if ta.Value.Global.MA != 1 {
+		t.Errorf("Value.Global.MA = %v, want 1", ta.Value.Global.MA)
+	}
+
+	osc := ta.Value.Oscillators
+	if osc.RSI < 50 || osc.ADX.PlusDI <= osc.ADX.MinusDI || osc.MACD.Macd <= 0 {
+		t.Errorf("oscillators do not show the uptrend: %+v", osc)
+	}
+	if ta.Value.Prices.Close != bars[299].Close {
+		t.Errorf("Close = %v", ta.Value.Prices.Close)
+	}
+}
+
+func TestComputeDowntrend(t *testing.T) {
+	ta, err := Compute(trendBars(300, -1))
+	if err != nil {
+		t.Fatalf("Compute() error = %v", err)
+	}
+	if ta.Recommend.Global.MA != tradingview.SignalStrongSell {
+		t.Errorf("Global.MA = %v, want STRONG_SELL", ta.Recommend.Global.MA)
+	}
+	if ta.Value.Oscillators.RSI > 50 {
+		t.Errorf("RSI = %v, want below 50", ta.Value.Oscillators.RSI)
+	}
+}
+
+func TestComputeShortHistory(t *testing.T) {
+	ta, err := Compute(trendBars(60, 1))
+	if err != nil {
+		t.Fatalf("Compute() error = %v", err)
+	}
+	for _, field := range []string{"EMA100", "SMA200", "Rec.Ichimoku"} {
+		if ta.IsSet(field) {
+			t.Errorf("expected %s to be missing with 60 bars", field)
+		}
+	}
+	for _, field := range []string{"RSI", "EMA50", "Rec.Stoch.RSI", "Recommend.All"} {
+		if !ta.IsSet(field) {
+			t.Errorf("expected %s to be set with 60 bars", field)
+		}
+	}
+	if ta.Recommend.MovingAverages.SMA200 != tradingview.SignalNeutral {
+		t.Errorf("SMA200 = %v, want NEUTRAL without data", ta.Recommend.MovingAverages.SMA200)
+	}
+}
+
+func TestComputeNoBars(t *testing.T) {
+	if _, err := Compute(nil); !errors.Is(err, ErrNoBars) {
+		t.Fatalf("expected ErrNoBars, got %v", err)
+	}
+}
+
+func TestFields(t *testing.T) {
+	bars := trendBars(300, 1)
+	fields := Fields(bars)
+
+	rsi := RSI(closes(bars), 14)
+	if fields["RSI"] != rsi[299] || fields["RSI[1]"] != rsi[298] {
+		t.Errorf("RSI fields = %v, %v", fields["RSI"], fields["RSI[1]"])
+	}
+	if fields["SMA20"] != SMA(closes(bars), 20)[299] {
+		t.Errorf("SMA20 = %v", fields["SMA20"])
+	}
+	if fields["high"] != bars[299].High || fields["low"] != bars[299].Low {
+		t.Errorf("high, low = %v, %v", fields["high"], fields["low"])
+	}
+	for name, v := range fields {
+		if math.IsNaN(v) {
+			t.Errorf("%s is NaN", name)
+		}
+	}
+}
+
+func TestRatings(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"StochRSI buy", rateStochRSI(10, 5, -1), 1},
+		{"StochRSI buy needs downtrend", rateStochRSI(10, 5, 1), 0},
+		{"StochRSI sell", rateStochRSI(85, 90, 1), -1},
+		{"WR buy", rateWR(-90, -95), 1},
+		{"WR sell", rateWR(-10, -5), -1},
+		{"WR neutral", rateWR(-50, -60), 0},
+		{"BBPower buy", rateBBPower(2, 1, -1, -2, 1), 1},
+		{"BBPower sell", rateBBPower(1, 2, -2, -1, -1), -1},
+		{"UO buy", rateUO(75), 1},
+		{"UO sell", rateUO(25), -1},
+		{"Ichimoku buy", rateIchimoku(12, 11, 10.5, 10, 13), 1},
+		{"Ichimoku sell", rateIchimoku(8, 9, 9.5, 10, 7), -1},
+		{"Ichimoku inside cloud", rateIchimoku(12, 11, 14, 10, 13), 0},
+		{"MA buy", rateMA(9, 10), 1},
+		{"MA sell", rateMA(11, 10), -1},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if !math.IsNaN(rateUO(math.NaN())) || !math.IsNaN(rateMA(1, math.NaN())) {
+		t.Error("expected unknown inputs to give NaN")
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import "math"
+
+// RSI returns the relative strength index of values over length bars, using
+// Wilder's smoothing.
+func RSI(values []float64, length int) []float64 {
+	change := Change(values, 1)
+	gains := make([]float64, len(values))
+	losses := make([]float64, len(values))
+	for i, c := range change {
+		gains[i] = max(c, 0)
+		losses[i] = max(-c, 0)
+	}
+
+	up, down := RMA(gains, length), RMA(losses, length)
+	out := nanSeries(len(values))
+	for i := range values {
+		switch {
+		case math.IsNaN(up[i]) || math.IsNaN(down[i]):
+		case down[i] == 0:
+			out[i] = 100
+		case up[i] == 0:
+			out[i] = 0
+		default:
+			out[i] = 100 - 100/(1+up[i]/down[i])
+		}
+	}
+	return out
+}
+
+// Stoch returns the stochastic oscillator of bars: %K is the position of the
+// close within the range of the last kLength bars, smoothed over kSmooth
+// bars, and %D is %K smoothed over dSmooth bars.
+func Stoch(bars []Bar, kLength, kSmooth, dSmooth int) (k, d []float64) {
+	high, low, close := highs(bars), lows(bars), closes(bars)
+	k = SMA(stoch(close, high, low, kLength), kSmooth)
+	return k, SMA(k, dSmooth)
+}
+
+// StochRSI returns the stochastic oscillator applied to the RSI of values
+// over rsiLength bars.
+func StochRSI(values []float64, rsiLength, stochLength, kSmooth, dSmooth int) (k, d []float64) {
+	rsi := RSI(values, rsiLength)
+	k = SMA(stoch(rsi, rsi, rsi, stochLength), kSmooth)
+	return k, SMA(k, dSmooth)
+}
+
+// CCI returns the commodity channel index of the typical price of bars over
+// length bars.
+func CCI(bars []Bar, length int) []float64 {
+	tp := make([]float64, len(bars))
+	for i, b := range bars {
+		tp[i] = (b.High + b.Low + b.Close) / 3
+	}
+
+	ma := SMA(tp, length)
+	out := nanSeries(len(bars))
+	for i := range bars {
+		w, ok := window(tp, i, length)
+		if !ok {
+			continue
+		}
+		var dev float64
+		for _, v := range w {
+			dev += math.Abs(v - ma[i])
+		}
+		dev /= float64(length)
+		if dev == 0 {
+			out[i] = 0
+			continue
+		}
+		out[i] = (tp[i] - ma[i]) / (0.015 * dev)
+	}
+	return out
+}
+
+// DMI returns the directional movement index of bars: the +DI and -DI lines
+// over diLength bars and the ADX smoothed over adxLength bars.
+func DMI(bars []Bar, diLength, adxLength int) (plusDI, minusDI, adx []float64) {
+	n := len(bars)
+	tr, plusDM, minusDM := nanSeries(n), nanSeries(n), nanSeries(n)
+	for i := 1; i < n; i++ {
+		cur, prev := bars[i], bars[i-1]
+		up, down := cur.High-prev.High, prev.Low-cur.Low
+		plusDM[i], minusDM[i] = 0, 0
+		if up > down && up > 0 {
+			plusDM[i] = up
+		}
+		if down > up && down > 0 {
+			minusDM[i] = down
+		}
+		tr[i] = max(cur.High-cur.Low, math.Abs(cur.High-prev.Close), math.Abs(cur.Low-prev.Close))
+	}
+
+	atr, plus, minus := RMA(tr, diLength), RMA(plusDM, diLength), RMA(minusDM, diLength)
+	plusDI, minusDI = nanSeries(n), nanSeries(n)
+	dx := nanSeries(n)
+	for i := range bars {
+		if math.IsNaN(atr[i]) || atr[i] == 0 {
+			continue
+		}
+		plusDI[i] = 100 * plus[i] / atr[i]
+		minusDI[i] = 100 * minus[i] / atr[i]
+		if total := plusDI[i] + minusDI[i]; total != 0 {
+			dx[i] = 100 * math.Abs(plusDI[i]-minusDI[i]) / total
+		} else {
+			dx[i] = 0
+		}
+	}
+	return plusDI, minusDI, RMA(dx, adxLength)
+}
+
+// AO returns the awesome oscillator of bars: the 5-bar SMA of the median
+// price minus its 34-bar SMA.
+func AO(bars []Bar) []float64 {
+	hl2 := make([]float64, len(bars))
+	for i, b := range bars {
+		hl2[i] = (b.High + b.Low) / 2
+	}
+	fast, slow := SMA(hl2, 5), SMA(hl2, 34)
+	out := make([]float64, len(bars))
+	for i := range out {
+		out[i] = fast[i] - slow[i]
+	}
+	return out
+}
+
+// MACD returns the MACD line of values, the fast EMA minus the slow EMA, and
+// its signal line, the EMA of the MACD line over signalLength bars.
+func MACD(values []float64, fastLength, slowLength, signalLength int) (macd, signal []float64) {
+	fast, slow := EMA(values, fastLength), EMA(values, slowLength)
+	macd = make([]float64, len(values))
+	for i := range macd {
+		macd[i] = fast[i] - slow[i]
+	}
+	return macd, EMA(macd, signalLength)
+}
+
+// WilliamsR returns the Williams percent range of bars over length bars,
+// between -100 and 0.
+func WilliamsR(bars []Bar, length int) []float64 {
+	high, low := Highest(highs(bars), length), Lowest(lows(bars), length)
+	out := nanSeries(len(bars))
+	for i, b := range bars {
+		if r := high[i] - low[i]; r != 0 {
+			out[i] = 100 * (b.Close - high[i]) / r
+		}
+	}
+	return out
+}
+
+// BullBearPower returns the Elder bull power, the high minus the EMA of the
+// close over length bars, and bear power, the low minus that EMA.
+// TradingView's Bull Bear Power value is their sum.
+func BullBearPower(bars []Bar, length int) (bull, bear []float64) {
+	ema := EMA(closes(bars), length)
+	bull, bear = make([]float64, len(bars)), make([]float64, len(bars))
+	for i, b := range bars {
+		bull[i] = b.High - ema[i]
+		bear[i] = b.Low - ema[i]
+	}
+	return bull, bear
+}
+
+// UltimateOscillator returns the ultimate oscillator of bars, combining the
+// buying pressure over the fast, middle and slow lengths with weights 4, 2
+// and 1.
+func UltimateOscillator(bars []Bar, fast, middle, slow int) []float64 {
+	n := len(bars)
+	bp, tr := nanSeries(n), nanSeries(n)
+	for i := 1; i < n; i++ {
+		low := min(bars[i].Low, bars[i-1].Close)
+		bp[i] = bars[i].Close - low
+		tr[i] = max(bars[i].High, bars[i-1].Close) - low
+	}
+
+	average := func(length int) []float64 {
+		out := nanSeries(n)
+		for i := range bars {
+			b, ok1 := window(bp, i, length)
+			t, ok2 := window(tr, i, length)
+			if ok1 && ok2 {
+				if total := sum(t); total != 0 {
+					out[i] = sum(b) / total
+				}
+			}
+		}
+		return out
+	}
+	a1, a2, a3 := average(fast), average(middle), average(slow)
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = 100 * (4*a1[i] + 2*a2[i] + a3[i]) / 7
+	}
+	return out
+}
+
+// stoch returns the position of source within the range of high and low over
+// length bars, between 0 and 100.
+func stoch(source, high, low []float64, length int) []float64 {
+	highest, lowest := Highest(high, length), Lowest(low, length)
+	out := nanSeries(len(source))
+	for i, v := range source {
+		if r := highest[i] - lowest[i]; r != 0 {
+			out[i] = 100 * (v - lowest[i]) / r
+		}
+	}
+	return out
+}
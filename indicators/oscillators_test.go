@@ -0,0 +1,151 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import (
+	"math"
+	"testing"
+)
+
+// risingBars returns n bars rising by 1 per bar, with a range of 1 and the
+// close in the middle.
+func risingBars(n int) []Bar {
+	bars := make([]Bar, n)
+	for i := range bars {
+		low := float64(i)
+		bars[i] = Bar{Open: low + 0.5, High: low + 1, Low: low, Close: low + 0.5, Volume: 100}
+	}
+	return bars
+}
+
+func TestRSI(t *testing.T) {
+	// The 14-day RSI example from StockCharts' "Relative Strength Index"
+	// article. StockCharts rounds the average gain and loss, so its table
+	// differs in the second decimal; these are the unrounded values.
+	closes := []float64{
+		44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42, 45.84, 46.08,
+		45.89, 46.03, 45.61, 46.28, 46.28, 46.00, 46.03, 46.41, 46.22, 45.64,
+		46.21, 46.25, 45.71, 46.45, 45.78, 45.35, 44.03, 44.18, 44.22, 44.57,
+		43.42, 42.66, 43.13,
+	}
+	want := []float64{
+		nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan, nan,
+		70.46, 66.25, 66.48, 69.35, 66.29, 57.92, 62.88, 63.21, 56.01, 62.34,
+		54.67, 50.39, 40.02, 41.49, 41.90, 45.50, 37.32, 33.09, 37.79,
+	}
+	assertSeries(t, "RSI", RSI(closes, 14), want)
+
+	assertSeries(t, "RSI rising", RSI([]float64{1, 2, 3, 4}, 2), []float64{nan, nan, 100, 100})
+	assertSeries(t, "RSI falling", RSI([]float64{4, 3, 2, 1}, 2), []float64{nan, nan, 0, 0})
+}
+
+func TestStoch(t *testing.T) {
+	bars := []Bar{
+		{High: 10, Low: 0, Close: 5},
+		{High: 10, Low: 0, Close: 10},
+		{High: 10, Low: 0, Close: 0},
+		{High: 10, Low: 0, Close: 8},
+	}
+	k, d := Stoch(bars, 2, 1, 2)
+	assertSeries(t, "Stoch %K", k, []float64{nan, 100, 0, 80})
+	assertSeries(t, "Stoch %D", d, []float64{nan, nan, 50, 40})
+}
+
+func TestStochRSI(t *testing.T) {
+	values := make([]float64, 60)
+	for i := range values {
+		values[i] = 100 + 10*math.Sin(float64(i)/4)
+	}
+	k, d := StochRSI(values, 14, 14, 3, 3)
+
+	// RSI needs 15 values, the stochastic 13 more, and each smoothing 2 more.
+	for i, v := range k {
+		if (i < 29) != math.IsNaN(v) {
+			t.Fatalf("StochRSI %%K[%d] = %v", i, v)
+		}
+		if v < 0 || v > 100 {
+			t.Fatalf("StochRSI %%K[%d] = %v is out of range", i, v)
+		}
+	}
+	if !math.IsNaN(d[30]) || math.IsNaN(d[31]) {
+		t.Fatalf("StochRSI %%D starts at the wrong bar: %v", d[29:33])
+	}
+}
+
+func TestCCI(t *testing.T) {
+	bars := []Bar{
+		{High: 1, Low: 1, Close: 1},
+		{High: 2, Low: 2, Close: 2},
+		{High: 3, Low: 3, Close: 3},
+		{High: 3, Low: 3, Close: 3},
+	}
+	// At the third bar the typical prices are 1, 2, 3: the mean is 2 and the
+	// mean deviation 2/3, so CCI = (3-2) / (0.015 * 2/3) = 100.
+	assertSeries(t, "CCI", CCI(bars, 3), []float64{nan, nan, 100, 50})
+}
+
+func TestDMI(t *testing.T) {
+	plusDI, minusDI, adx := DMI(risingBars(40), 14, 14)
+
+	// Every bar moves up by 1 with a true range of 1.5.
+	last := len(adx) - 1
+	if math.Abs(plusDI[last]-100/1.5) > 1e-9 || minusDI[last] != 0 || math.Abs(adx[last]-100) > 1e-9 {
+		t.Fatalf("DMI = %v, %v, %v", plusDI[last], minusDI[last], adx[last])
+	}
+	if !math.IsNaN(plusDI[13]) || math.IsNaN(plusDI[14]) {
+		t.Fatalf("+DI starts at the wrong bar: %v", plusDI[12:16])
+	}
+	if !math.IsNaN(adx[26]) || math.IsNaN(adx[27]) {
+		t.Fatalf("ADX starts at the wrong bar: %v", adx[25:29])
+	}
+}
+
+func TestAO(t *testing.T) {
+	bars := risingBars(40)
+	ao := AO(bars)
+	// On a straight line the 5 and 34 bar SMAs are 2 and 16.5 bars behind.
+	if !math.IsNaN(ao[32]) || math.Abs(ao[33]-14.5) > 1e-9 || math.Abs(ao[39]-14.5) > 1e-9 {
+		t.Fatalf("AO = %v", ao[32:])
+	}
+}
+
+func TestMACD(t *testing.T) {
+	values := make([]float64, 50)
+	for i := range values {
+		values[i] = 10
+	}
+	macd, signal := MACD(values, 12, 26, 9)
+	if !math.IsNaN(macd[24]) || macd[25] != 0 || !math.IsNaN(signal[32]) || signal[33] != 0 {
+		t.Fatalf("MACD = %v, signal = %v", macd[24:26], signal[32:34])
+	}
+}
+
+func TestWilliamsR(t *testing.T) {
+	bars := []Bar{
+		{High: 10, Low: 0, Close: 5},
+		{High: 10, Low: 0, Close: 10},
+		{High: 10, Low: 0, Close: 2},
+	}
+	assertSeries(t, "WilliamsR", WilliamsR(bars, 2), []float64{nan, 0, -80})
+}
+
+func TestBullBearPower(t *testing.T) {
+	bars := make([]Bar, 20)
+	for i := range bars {
+		bars[i] = Bar{High: 11, Low: 9, Close: 10}
+	}
+	bull, bear := BullBearPower(bars, 13)
+	if !math.IsNaN(bull[11]) || bull[12] != 1 || bear[12] != -1 {
+		t.Fatalf("BullBearPower = %v, %v", bull[11:13], bear[11:13])
+	}
+}
+
+func TestUltimateOscillator(t *testing.T) {
+	uo := UltimateOscillator(risingBars(40), 7, 14, 28)
+	// Buying pressure is 1 and true range 1.5 on every bar.
+	if !math.IsNaN(uo[27]) || math.Abs(uo[28]-100/1.5) > 1e-9 {
+		t.Fatalf("UltimateOscillator = %v", uo[27:29])
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import (
+	"time"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// The pivot functions compute TradingView's pivot point levels from the
+// open, high, low and close of the previous period, prev. Middle is the
+// pivot point itself.
+
+// ClassicPivots returns the Classic pivot levels:
+//
+//	PP = (H + L + C) / 3
+//	R1 = 2PP - L        S1 = 2PP - H
+//	R2 = PP + (H - L)   S2 = PP - (H - L)
+//	R3 = PP + 2(H - L)  S3 = PP - 2(H - L)
+func ClassicPivots(prev Bar) tradingview.ClassicPivotLevels {
+	pp := typicalPrice(prev)
+	r := prev.High - prev.Low
+	return tradingview.ClassicPivotLevels{
+		Middle: pp,
+		R1:     2*pp - prev.Low,
+		R2:     pp + r,
+		R3:     pp + 2*r,
+		S1:     2*pp - prev.High,
+		S2:     pp - r,
+		S3:     pp - 2*r,
+	}
+}
+
+// FibonacciPivots returns the Fibonacci pivot levels, placed at 0.382, 0.618
+// and 1 times the range of prev from the Classic pivot point.
+func FibonacciPivots(prev Bar) tradingview.FibonacciPivotLevels {
+	pp := typicalPrice(prev)
+	r := prev.High - prev.Low
+	return tradingview.FibonacciPivotLevels{
+		Middle: pp,
+		R1:     pp + 0.382*r,
+		R2:     pp + 0.618*r,
+		R3:     pp + r,
+		S1:     pp - 0.382*r,
+		S2:     pp - 0.618*r,
+		S3:     pp - r,
+	}
+}
+
+// CamarillaPivots returns the Camarilla pivot levels, placed at 1.1/12,
+// 1.1/6 and 1.1/4 times the range of prev from its close. Middle is the
+// Classic pivot point.
+func CamarillaPivots(prev Bar) tradingview.CamarillaPivotLevels {
+	r := 1.1 * (prev.High - prev.Low)
+	return tradingview.CamarillaPivotLevels{
+		Middle: typicalPrice(prev),
+		R1:     prev.Close + r/12,
+		R2:     prev.Close + r/6,
+		R3:     prev.Close + r/4,
+		S1:     prev.Close - r/12,
+		S2:     prev.Close - r/6,
+		S3:     prev.Close - r/4,
+	}
+}
+
+// WoodiePivots returns the Woodie pivot levels, which weight open, the
+// opening price of the current period:
+//
+//	PP = (H + L + 2 open) / 4
+//	R1 = 2PP - L         S1 = 2PP - H
+//	R2 = PP + (H - L)    S2 = PP - (H - L)
+//	R3 = H + 2(PP - L)   S3 = L - 2(H - PP)
+func WoodiePivots(prev Bar, open float64) tradingview.WoodiePivotLevels {
+	pp := (prev.High + prev.Low + 2*open) / 4
+	r := prev.High - prev.Low
+	return tradingview.WoodiePivotLevels{
+		Middle: pp,
+		R1:     2*pp - prev.Low,
+		R2:     pp + r,
+		R3:     prev.High + 2*(pp-prev.Low),
+		S1:     2*pp - prev.High,
+		S2:     pp - r,
+		S3:     prev.Low - 2*(prev.High-pp),
+	}
+}
+
+// DemarkPivots returns the DeMark pivot levels. X is H + 2L + C when prev
+// closed below its open, 2H + L + C when it closed above, and H + L + 2C
+// otherwise; PP = X/4, R1 = X/2 - L and S1 = X/2 - H.
+func DemarkPivots(prev Bar) tradingview.DemarkPivotLevels {
+	var x float64
+	switch {
+	case prev.Close < prev.Open:
+		x = prev.High + 2*prev.Low + prev.Close
+	case prev.Close > prev.Open:
+		x = 2*prev.High + prev.Low + prev.Close
+	default:
+		x = prev.High + prev.Low + 2*prev.Close
+	}
+	return tradingview.DemarkPivotLevels{
+		Middle: x / 4,
+		R1:     x/2 - prev.Low,
+		S1:     x/2 - prev.High,
+	}
+}
+
+// Pivots returns the levels of all five pivot systems for the previous
+// period prev and the opening price of the current period open.
+func Pivots(prev Bar, open float64) tradingview.PivotValues {
+	return tradingview.PivotValues{
+		Classic:   ClassicPivots(prev),
+		Fibonacci: FibonacciPivots(prev),
+		Camarilla: CamarillaPivots(prev),
+		Woodie:    WoodiePivots(prev, open),
+		Demark:    DemarkPivots(prev),
+	}
+}
+
+// Aggregate combines bars, ordered from oldest to newest, into one bar for
+// the whole period: the first open, the highest high, the lowest low, the
+// last close and the total volume. Its Time is that of the first bar. It
+// returns the zero Bar if bars is empty.
+func Aggregate(bars []Bar) Bar {
+	if len(bars) == 0 {
+		return Bar{}
+	}
+	out := bars[0]
+	for _, b := range bars[1:] {
+		out.High = max(out.High, b.High)
+		out.Low = min(out.Low, b.Low)
+		out.Close = b.Close
+		out.Volume += b.Volume
+	}
+	return out
+}
+
+// monthlyPivots returns the pivot levels the scanner reports as "Pivot.M",
+// computed from the calendar month in UTC before the month of the last bar.
+// It returns false if bars have no times or do not cover that month.
+func monthlyPivots(bars []Bar) (tradingview.PivotValues, bool) {
+	if len(bars) == 0 || bars[len(bars)-1].Time.IsZero() {
+		return tradingview.PivotValues{}, false
+	}
+
+	current := monthStart(bars[len(bars)-1].Time)
+	previous := current.AddDate(0, -1, 0)
+	var prev, cur []Bar
+	for _, b := range bars {
+		switch t := b.Time.UTC(); {
+		case !t.Before(current):
+			cur = append(cur, b)
+		case !t.Before(previous):
+			prev = append(prev, b)
+		}
+	}
+	if len(prev) == 0 || len(cur) == 0 {
+		return tradingview.PivotValues{}, false
+	}
+	return Pivots(Aggregate(prev), cur[0].Open), true
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func typicalPrice(b Bar) float64 {
+	return (b.High + b.Low + b.Close) / 3
+}
@@ -0,0 +1,145 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// prevPeriod has a pivot point of 101.6667 and a range of 20.
+var prevPeriod = Bar{Open: 95, High: 110, Low: 90, Close: 105}
+
+// assertLevels compares two level structs field by field to within 1e-4.
+func assertLevels(t *testing.T, name string, got, want tradingview.ClassicPivotLevels) {
+	t.Helper()
+	pairs := []struct {
+		level     string
+		got, want float64
+	}{
+		{"Middle", got.Middle, want.Middle},
+		{"R1", got.R1, want.R1}, {"R2", got.R2, want.R2}, {"R3", got.R3, want.R3},
+		{"S1", got.S1, want.S1}, {"S2", got.S2, want.S2}, {"S3", got.S3, want.S3},
+	}
+	for _, p := range pairs {
+		if math.Abs(p.got-p.want) > 1e-4 {
+			t.Errorf("%s %s = %.4f, want %.4f", name, p.level, p.got, p.want)
+		}
+	}
+}
+
+func TestPivots(t *testing.T) {
+	tests := []struct {
+		name string
+		got  tradingview.ClassicPivotLevels
+		want tradingview.ClassicPivotLevels
+	}{
+		{
+			name: "Classic",
+			got:  ClassicPivots(prevPeriod),
+			want: tradingview.ClassicPivotLevels{Middle: 101.6667, R1: 113.3333, R2: 121.6667, R3: 141.6667, S1: 93.3333, S2: 81.6667, S3: 61.6667},
+		},
+		{
+			name: "Fibonacci",
+			got:  tradingview.ClassicPivotLevels(FibonacciPivots(prevPeriod)),
+			want: tradingview.ClassicPivotLevels{Middle: 101.6667, R1: 109.3067, R2: 114.0267, R3: 121.6667, S1: 94.0267, S2: 89.3067, S3: 81.6667},
+		},
+		{
+			name: "Camarilla",
+			got:  tradingview.ClassicPivotLevels(CamarillaPivots(prevPeriod)),
+			want: tradingview.ClassicPivotLevels{Middle: 101.6667, R1: 106.8333, R2: 108.6667, R3: 110.5, S1: 103.1667, S2: 101.3333, S3: 99.5},
+		},
+		{
+			name: "Woodie",
+			got:  tradingview.ClassicPivotLevels(WoodiePivots(prevPeriod, 104)),
+			want: tradingview.ClassicPivotLevels{Middle: 102, R1: 114, R2: 122, R3: 134, S1: 94, S2: 82, S3: 74},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertLevels(t, tt.name, tt.got, tt.want)
+		})
+	}
+}
+
+func TestDemarkPivots(t *testing.T) {
+	tests := []struct {
+		name string
+		open float64
+		want tradingview.DemarkPivotLevels
+	}{
+		{name: "close above open", open: 95, want: tradingview.DemarkPivotLevels{Middle: 103.75, R1: 117.5, S1: 97.5}},
+		{name: "close below open", open: 108, want: tradingview.DemarkPivotLevels{Middle: 98.75, R1: 107.5, S1: 87.5}},
+		{name: "close at open", open: 105, want: tradingview.DemarkPivotLevels{Middle: 102.5, R1: 115, S1: 95}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := prevPeriod
+			prev.Open = tt.open
+			if got := DemarkPivots(prev); got != tt.want {
+				t.Fatalf("DemarkPivots() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPivotsAll(t *testing.T) {
+	got := Pivots(prevPeriod, 104)
+	if got.Classic != ClassicPivots(prevPeriod) || got.Woodie != WoodiePivots(prevPeriod, 104) || got.Demark != DemarkPivots(prevPeriod) {
+		t.Fatalf("Pivots() = %+v", got)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	bars := []Bar{
+		{Time: start, Open: 10, High: 12, Low: 9, Close: 11, Volume: 1},
+		{Time: start.Add(time.Hour), Open: 11, High: 15, Low: 10, Close: 14, Volume: 2},
+		{Time: start.Add(2 * time.Hour), Open: 14, High: 14, Low: 7, Close: 8, Volume: 3},
+	}
+	want := Bar{Time: start, Open: 10, High: 15, Low: 7, Close: 8, Volume: 6}
+	if got := Aggregate(bars); got != want {
+		t.Fatalf("Aggregate() = %+v, want %+v", got, want)
+	}
+	if got := Aggregate(nil); got != (Bar{}) {
+		t.Fatalf("Aggregate(nil) = %+v", got)
+	}
+}
+
+func TestComputeMonthlyPivots(t *testing.T) {
+	// Daily bars from 2026-01-01 to 2026-02-10: January has a low of 90, a
+	// high of 110 and closes at 105, and February opens at 104.
+	var bars []Bar
+	for day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); day.Month() == time.January; day = day.AddDate(0, 0, 1) {
+		bars = append(bars, Bar{Time: day, Open: 100, High: 101, Low: 99, Close: 100})
+	}
+	bars[0].Open, bars[5].High, bars[9].Low, bars[len(bars)-1].Close = 95, 110, 90, 105
+	for day := 1; day <= 10; day++ {
+		bars = append(bars, Bar{Time: time.Date(2026, 2, day, 0, 0, 0, 0, time.UTC), Open: 104, High: 106, Low: 103, Close: 105})
+	}
+
+	ta, err := Compute(bars)
+	if err != nil {
+		t.Fatalf("Compute() error = %v", err)
+	}
+	if got, want := ta.Value.Pivots, Pivots(prevPeriod, 104); got != want {
+		t.Fatalf("Pivots = %+v, want %+v", got, want)
+	}
+	for _, field := range ta.Missing {
+		if strings.HasPrefix(field, "Pivot.") {
+			t.Fatalf("unexpected missing field %q", field)
+		}
+	}
+
+	// Without the previous month there are no pivots.
+	ta, _ = Compute(bars[31:])
+	if ta.IsSet("Pivot.M.Classic.Middle") {
+		t.Fatal("expected pivots to be missing without the previous month")
+	}
+}
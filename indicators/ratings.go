@@ -0,0 +1,116 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import "math"
+
+// The rate functions return 1 for buy, -1 for sell, 0 for neutral, and NaN
+// if an input is unknown, matching the scanner's "Rec.*" fields.
+
+// trendSeries returns 1 where values are above their 50-bar SMA, -1 where
+// they are below it, and NaN before the SMA is known.
+func trendSeries(values []float64) []float64 {
+	sma := SMA(values, 50)
+	out := nanSeries(len(values))
+	for i, v := range values {
+		switch {
+		case math.IsNaN(sma[i]):
+		case v > sma[i]:
+			out[i] = 1
+		case v < sma[i]:
+			out[i] = -1
+		default:
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+func rateStochRSI(k, d, trend float64) float64 {
+	switch {
+	case anyNaN(k, d, trend):
+		return math.NaN()
+	case trend < 0 && k < 20 && d < 20 && k > d:
+		return 1
+	case trend > 0 && k > 80 && d > 80 && k < d:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func rateWR(wr, wr1 float64) float64 {
+	switch {
+	case anyNaN(wr, wr1):
+		return math.NaN()
+	case wr < -80 && wr > wr1:
+		return 1
+	case wr > -20 && wr < wr1:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func rateBBPower(bull, bull1, bear, bear1, trend float64) float64 {
+	switch {
+	case anyNaN(bull, bull1, bear, bear1, trend):
+		return math.NaN()
+	case trend > 0 && bear < 0 && bear > bear1:
+		return 1
+	case trend < 0 && bull > 0 && bull < bull1:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func rateUO(uo float64) float64 {
+	switch {
+	case math.IsNaN(uo):
+		return math.NaN()
+	case uo > 70:
+		return 1
+	case uo < 30:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func rateIchimoku(conversion, base, leadA, leadB, close float64) float64 {
+	switch {
+	case anyNaN(conversion, base, leadA, leadB, close):
+		return math.NaN()
+	case leadA > leadB && close > leadA && close > base && conversion > base:
+		return 1
+	case leadA < leadB && close < leadA && close < base && conversion < base:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func rateMA(ma, close float64) float64 {
+	switch {
+	case anyNaN(ma, close):
+		return math.NaN()
+	case ma < close:
+		return 1
+	case ma > close:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func anyNaN(values ...float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) {
+			return true
+		}
+	}
+	return false
+}
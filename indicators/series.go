@@ -0,0 +1,149 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import "math"
+
+// SMA returns the simple moving average of values over length bars.
+func SMA(values []float64, length int) []float64 {
+	out := nanSeries(len(values))
+	for i := range values {
+		if w, ok := window(values, i, length); ok {
+			out[i] = sum(w) / float64(length)
+		}
+	}
+	return out
+}
+
+// EMA returns the exponential moving average of values over length bars,
+// seeded with the simple moving average of the first length values as
+// TradingView does.
+func EMA(values []float64, length int) []float64 {
+	return smoothed(values, length, 2/float64(length+1))
+}
+
+// RMA returns Wilder's moving average of values over length bars, the
+// smoothing used by RSI and ADX.
+func RMA(values []float64, length int) []float64 {
+	return smoothed(values, length, 1/float64(length))
+}
+
+// WMA returns the linearly weighted moving average of values over length
+// bars, with the most recent value weighted most.
+func WMA(values []float64, length int) []float64 {
+	out := nanSeries(len(values))
+	norm := float64(length*(length+1)) / 2
+	for i := range values {
+		w, ok := window(values, i, length)
+		if !ok {
+			continue
+		}
+		var total float64
+		for j, v := range w {
+			total += v * float64(j+1)
+		}
+		out[i] = total / norm
+	}
+	return out
+}
+
+// HMA returns the Hull moving average of values over length bars:
+// WMA(2*WMA(values, length/2) - WMA(values, length), sqrt(length)).
+func HMA(values []float64, length int) []float64 {
+	half := WMA(values, length/2)
+	full := WMA(values, length)
+	diff := make([]float64, len(values))
+	for i := range values {
+		diff[i] = 2*half[i] - full[i]
+	}
+	return WMA(diff, int(math.Sqrt(float64(length))))
+}
+
+// Highest returns the highest of values over length bars.
+func Highest(values []float64, length int) []float64 {
+	out := nanSeries(len(values))
+	for i := range values {
+		if w, ok := window(values, i, length); ok {
+			out[i] = w[0]
+			for _, v := range w[1:] {
+				out[i] = max(out[i], v)
+			}
+		}
+	}
+	return out
+}
+
+// Lowest returns the lowest of values over length bars.
+func Lowest(values []float64, length int) []float64 {
+	out := nanSeries(len(values))
+	for i := range values {
+		if w, ok := window(values, i, length); ok {
+			out[i] = w[0]
+			for _, v := range w[1:] {
+				out[i] = min(out[i], v)
+			}
+		}
+	}
+	return out
+}
+
+// Change returns the difference between each value and the value length
+// bars earlier.
+func Change(values []float64, length int) []float64 {
+	out := nanSeries(len(values))
+	for i := length; i < len(values); i++ {
+		out[i] = values[i] - values[i-length]
+	}
+	return out
+}
+
+// smoothed returns the recursive moving average of values with factor
+// alpha, seeded with the SMA of the first length values.
+func smoothed(values []float64, length int, alpha float64) []float64 {
+	out := nanSeries(len(values))
+	seeded := false
+	for i, v := range values {
+		if seeded {
+			out[i] = alpha*v + (1-alpha)*out[i-1]
+			continue
+		}
+		if w, ok := window(values, i, length); ok {
+			out[i] = sum(w) / float64(length)
+			seeded = true
+		}
+	}
+	return out
+}
+
+// window returns the length values ending at index i, and whether there
+// are that many values and none of them is NaN.
+func window(values []float64, i, length int) ([]float64, bool) {
+	if length <= 0 || i+1 < length {
+		return nil, false
+	}
+	w := values[i+1-length : i+1]
+	for _, v := range w {
+		if math.IsNaN(v) {
+			return nil, false
+		}
+	}
+	return w, true
+}
+
+func sum(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func nanSeries(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = math.NaN()
+	}
+	return out
+}
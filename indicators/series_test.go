@@ -0,0 +1,91 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import (
+	"math"
+	"testing"
+)
+
+// assertSeries compares got with want to within 0.01. NaN in want means the
+// value must be NaN.
+func assertSeries(t *testing.T, name string, got, want []float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d values, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if math.IsNaN(want[i]) {
+			if !math.IsNaN(got[i]) {
+				t.Errorf("%s[%d] = %v, want NaN", name, i, got[i])
+			}
+			continue
+		}
+		if math.Abs(got[i]-want[i]) > 0.01 {
+			t.Errorf("%s[%d] = %.4f, want %.4f", name, i, got[i], want[i])
+		}
+	}
+}
+
+var nan = math.NaN()
+
+func TestSMA(t *testing.T) {
+	assertSeries(t, "SMA", SMA([]float64{1, 2, 3, 4, 5}, 3), []float64{nan, nan, 2, 3, 4})
+	assertSeries(t, "SMA NaN input", SMA([]float64{nan, 2, 4, 6}, 2), []float64{nan, nan, 3, 5})
+	assertSeries(t, "SMA zero length", SMA([]float64{1, 2}, 0), []float64{nan, nan})
+}
+
+func TestEMA(t *testing.T) {
+	// The 10-day EMA example from StockCharts' "Moving Averages" article.
+	closes := []float64{
+		22.27, 22.19, 22.08, 22.17, 22.18, 22.13, 22.23, 22.43, 22.24, 22.29,
+		22.15, 22.39, 22.38, 22.61, 23.36, 24.05, 23.75, 23.83, 23.95, 23.63,
+		23.82, 23.87, 23.65, 23.19, 23.10, 23.33, 22.68, 23.10, 22.40, 22.17,
+	}
+	want := []float64{
+		nan, nan, nan, nan, nan, nan, nan, nan, nan, 22.22,
+		22.21, 22.24, 22.27, 22.33, 22.52, 22.80, 22.97, 23.13, 23.28, 23.34,
+		23.43, 23.51, 23.53, 23.47, 23.40, 23.39, 23.26, 23.23, 23.08, 22.92,
+	}
+	assertSeries(t, "EMA", EMA(closes, 10), want)
+}
+
+func TestRMA(t *testing.T) {
+	assertSeries(t, "RMA", RMA([]float64{1, 3, 5, 7}, 2), []float64{nan, 2, 3.5, 5.25})
+}
+
+func TestWMA(t *testing.T) {
+	assertSeries(t, "WMA", WMA([]float64{1, 2, 3, 4}, 3), []float64{nan, nan, 14.0 / 6, 20.0 / 6})
+}
+
+func TestHMA(t *testing.T) {
+	// The Hull moving average has no lag on a straight line.
+	values := make([]float64, 20)
+	for i := range values {
+		values[i] = 2*float64(i) + 1
+	}
+	got := HMA(values, 9)
+	for i := range values {
+		if i < 10 {
+			if !math.IsNaN(got[i]) {
+				t.Errorf("HMA[%d] = %v, want NaN", i, got[i])
+			}
+			continue
+		}
+		if math.Abs(got[i]-values[i]) > 1e-9 {
+			t.Errorf("HMA[%d] = %v, want %v", i, got[i], values[i])
+		}
+	}
+}
+
+func TestHighestLowest(t *testing.T) {
+	values := []float64{3, 1, 4, 1, 5, 9, 2}
+	assertSeries(t, "Highest", Highest(values, 3), []float64{nan, nan, 4, 4, 5, 9, 9})
+	assertSeries(t, "Lowest", Lowest(values, 3), []float64{nan, nan, 1, 1, 1, 1, 2})
+}
+
+func TestChange(t *testing.T) {
+	assertSeries(t, "Change", Change([]float64{1, 4, 9, 16}, 2), []float64{nan, nan, 8, 12})
+}
@@ -0,0 +1,162 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Interval is a TradingView chart interval, such as Interval1Hour.
+//
+// Its value is the code TradingView uses for the interval. The zero value
+// requests daily data.
+type Interval string
+
+// Supported interval values for Get.
+const (
+	// Interval1Min requests 1-minute data.
+	Interval1Min Interval = "1"
+	// Interval5Min requests 5-minute data.
+	Interval5Min Interval = "5"
+	// Interval15Min requests 15-minute data.
+	Interval15Min Interval = "15"
+	// Interval30Min requests 30-minute data.
+	Interval30Min Interval = "30"
+	// Interval1Hour requests 1-hour data.
+	Interval1Hour Interval = "60"
+	// Interval2Hour requests 2-hour data.
+	Interval2Hour Interval = "120"
+	// Interval4Hour requests 4-hour data.
+	Interval4Hour Interval = "240"
+	// Interval1Day requests 1-day data.
+	Interval1Day Interval = "1D"
+	// Interval1Week requests 1-week data.
+	Interval1Week Interval = "1W"
+	// Interval1Month requests 1-month data.
+	Interval1Month Interval = "1M"
+)
+
+// ErrInvalidInterval reports that an interval is not supported.
+var ErrInvalidInterval = errors.New("tradingview: invalid interval")
+
+// intervalInfo describes a supported interval.
+type intervalInfo struct {
+	interval Interval
+	name     string        // short name returned by String
+	suffix   string        // scanner field suffix
+	duration time.Duration // nominal bar length
+	aliases  []string      // lower-case names accepted by ParseInterval
+}
+
+// intervalTable lists the supported intervals from shortest to longest.
+var intervalTable = []intervalInfo{
+	{Interval1Min, "1m", "|1", time.Minute, []string{"1m", "1min"}},
+	{Interval5Min, "5m", "|5", 5 * time.Minute, []string{"5m", "5min"}},
+	{Interval15Min, "15m", "|15", 15 * time.Minute, []string{"15m", "15min"}},
+	{Interval30Min, "30m", "|30", 30 * time.Minute, []string{"30m", "30min"}},
+	{Interval1Hour, "1h", "|60", time.Hour, []string{"1h", "60m", "1hour"}},
+	{Interval2Hour, "2h", "|120", 2 * time.Hour, []string{"2h", "120m", "2hour"}},
+	{Interval4Hour, "4h", "|240", 4 * time.Hour, []string{"4h", "240m", "4hour"}},
+	{Interval1Day, "1d", "", 24 * time.Hour, []string{"1d", "d", "1day"}},
+	{Interval1Week, "1w", "|1W", 7 * 24 * time.Hour, []string{"1w", "w", "1week"}},
+	{Interval1Month, "1mo", "|1M", 30 * 24 * time.Hour, []string{"1mo", "1mon", "1month"}},
+}
+
+// ParseInterval parses an interval code or a common alias.
+//
+// It accepts the TradingView codes used by the Interval constants, such as
+// "60" or "1D", and case-insensitive aliases such as "1h", "4H", "1d", "1w"
+// and "1mo". Because "1M" is TradingView's code for one month, minutes must
+// be written in lower case ("1m") or as "1min".
+func ParseInterval(text string) (Interval, error) {
+	text = strings.TrimSpace(text)
+	if text != "" && Interval(text).info() != nil {
+		return Interval(text), nil
+	}
+
+	lower := strings.ToLower(text)
+	for _, iv := range intervalTable {
+		for _, alias := range iv.aliases {
+			if lower == alias {
+				return iv.interval, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidInterval, text)
+}
+
+// Intervals returns the supported intervals from shortest to longest.
+func Intervals() []Interval {
+	all := make([]Interval, len(intervalTable))
+	for i, iv := range intervalTable {
+		all[i] = iv.interval
+	}
+	return all
+}
+
+// String returns the short name of i, such as "1h" or "1mo". The zero
+// Interval is reported as "1d". Unknown intervals are returned unchanged.
+func (i Interval) String() string {
+	if info := i.info(); info != nil {
+		return info.name
+	}
+	return string(i)
+}
+
+// Duration returns the nominal length of one bar at i. A month is reported
+// as 30 days. Duration returns 0 for unknown intervals.
+func (i Interval) Duration() time.Duration {
+	if info := i.info(); info != nil {
+		return info.duration
+	}
+	return 0
+}
+
+// info returns the table entry for i, or nil if i is not a TradingView
+// interval code. The zero Interval is daily.
+func (i Interval) info() *intervalInfo {
+	if i == "" {
+		i = Interval1Day
+	}
+	for k := range intervalTable {
+		if intervalTable[k].interval == i {
+			return &intervalTable[k]
+		}
+	}
+	return nil
+}
+
+// canonicalInterval returns the Interval constant for interval, which must
+// have been accepted by intervalSuffix. The empty interval, and unknown
+// intervals accepted in legacy mode, are daily.
+func canonicalInterval(interval Interval) Interval {
+	if parsed, err := ParseInterval(string(interval)); err == nil {
+		return parsed
+	}
+	return Interval1Day
+}
+
+// intervalSuffix returns the scanner field suffix for interval.
+//
+// The empty interval selects daily data. Aliases accepted by ParseInterval
+// are resolved; other values are rejected unless c.LegacyIntervals is set,
+// in which case they select daily data.
+func (c *Client) intervalSuffix(interval Interval) (string, error) {
+	if interval == "" {
+		return "", nil
+	}
+
+	parsed, err := ParseInterval(string(interval))
+	if err != nil {
+		if c != nil && c.LegacyIntervals {
+			return "", nil
+		}
+		return "", err
+	}
+	return parsed.info().suffix, nil
+}
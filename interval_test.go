@@ -0,0 +1,192 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    Interval
+		wantErr bool
+	}{
+		{"1", Interval1Min, false},
+		{"1m", Interval1Min, false},
+		{"1MIN", Interval1Min, false},
+		{"5m", Interval5Min, false},
+		{"15", Interval15Min, false},
+		{"30m", Interval30Min, false},
+		{"60", Interval1Hour, false},
+		{"1h", Interval1Hour, false},
+		{"1H", Interval1Hour, false},
+		{"2h", Interval2Hour, false},
+		{"4H", Interval4Hour, false},
+		{"240", Interval4Hour, false},
+		{"1D", Interval1Day, false},
+		{"1d", Interval1Day, false},
+		{"D", Interval1Day, false},
+		{"1W", Interval1Week, false},
+		{"1w", Interval1Week, false},
+		{"1M", Interval1Month, false},
+		{"1mo", Interval1Month, false},
+		{" 1h ", Interval1Hour, false},
+		{"", "", true},
+		{"3h", "", true},
+		{"1y", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseInterval(tt.text)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidInterval) {
+					t.Fatalf("expected ErrInvalidInterval, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseInterval(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterval_StringDuration(t *testing.T) {
+	tests := []struct {
+		interval Interval
+		name     string
+		duration time.Duration
+	}{
+		{Interval1Min, "1m", time.Minute},
+		{Interval5Min, "5m", 5 * time.Minute},
+		{Interval15Min, "15m", 15 * time.Minute},
+		{Interval30Min, "30m", 30 * time.Minute},
+		{Interval1Hour, "1h", time.Hour},
+		{Interval2Hour, "2h", 2 * time.Hour},
+		{Interval4Hour, "4h", 4 * time.Hour},
+		{Interval1Day, "1d", 24 * time.Hour},
+		{Interval1Week, "1w", 7 * 24 * time.Hour},
+		{Interval1Month, "1mo", 30 * 24 * time.Hour},
+		{"", "1d", 24 * time.Hour},
+		{"7", "7", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.interval.String(); got != tt.name {
+				t.Errorf("String() = %q, want %q", got, tt.name)
+			}
+			if got := tt.interval.Duration(); got != tt.duration {
+				t.Errorf("Duration() = %v, want %v", got, tt.duration)
+			}
+			if tt.duration != 0 {
+				parsed, err := ParseInterval(tt.name)
+				if err != nil || parsed.Duration() != tt.duration {
+					t.Errorf("ParseInterval(String()) = %q, %v", parsed, err)
+				}
+			}
+		})
+	}
+}
+
+func TestIntervals(t *testing.T) {
+	all := Intervals()
+	if len(all) != 10 || all[0] != Interval1Min || all[len(all)-1] != Interval1Month {
+		t.Fatalf("unexpected intervals: %v", all)
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i].Duration() <= all[i-1].Duration() {
+			t.Fatalf("intervals not ordered: %v", all)
+		}
+	}
+}
+
+func TestClient_intervalSuffix(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Client
+		interval Interval
+		want     string
+		wantErr  bool
+	}{
+		{name: "empty", client: &Client{}, interval: "", want: ""},
+		{name: "code", client: &Client{}, interval: Interval4Hour, want: "|240"},
+		{name: "daily", client: &Client{}, interval: Interval1Day, want: ""},
+		{name: "alias", client: &Client{}, interval: "1h", want: "|60"},
+		{name: "unknown", client: &Client{}, interval: "1y", wantErr: true},
+		{name: "nil client unknown", client: nil, interval: "1y", wantErr: true},
+		{name: "legacy unknown", client: &Client{LegacyIntervals: true}, interval: "1y", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.client.intervalSuffix(tt.interval)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidInterval) {
+					t.Fatalf("expected ErrInvalidInterval, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("intervalSuffix() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetInvalidInterval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	ta := &TradingView{}
+	if err := client.Get(ta, "BINANCE:BTCUSDT", "1y"); !errors.Is(err, ErrInvalidInterval) {
+		t.Fatalf("expected ErrInvalidInterval, got %v", err)
+	}
+	if _, err := client.GetIntervals("BINANCE:BTCUSDT", Interval1Hour, "1y"); !errors.Is(err, ErrInvalidInterval) {
+		t.Fatalf("expected ErrInvalidInterval, got %v", err)
+	}
+}
+
+func TestClient_GetLegacyIntervals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Query().Get("fields"), "|") {
+			http.Error(w, "expected daily fields", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"close": 1}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:      server.Client(),
+		BaseURL:         server.URL,
+		LegacyIntervals: true,
+	}
+
+	ta := &TradingView{}
+	if err := client.Get(ta, "BINANCE:BTCUSDT", "1y"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ta.Value.Prices.Close != 1 {
+		t.Fatalf("unexpected close price: %v", ta.Value.Prices.Close)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidTarget reports that the destination passed to GetInto is not a
+// non-nil pointer to a struct, or has a tagged field of unsupported type.
+var ErrInvalidTarget = errors.New("tradingview: invalid GetInto target")
+
+// GetInto fetches the fields named by the "tv" struct tags of dst for
+// symbol at interval and stores them in dst, which must be a non-nil
+// pointer to a struct. Only the tagged fields are requested.
+//
+// A tag names a scanner field without interval suffix, for example
+// `tv:"RSI"` or `tv:"Pivot.M.Classic.R1"`. Fields that do not depend on the
+// interval, such as "description", take the static option, as in
+// `tv:"description,static"`, and are requested without suffix. Untagged
+// struct fields are traversed as nested structs; a tag of "-" skips a
+// field.
+//
+// Tagged fields may be float64, int, string or pointers to those types, or
+// any type with one of those kinds. Fields TradingView does not return are
+// left at their zero value, which for pointers is nil; in strict mode they
+// are also reported in a *MissingFieldsError. Integer fields receive the
+// value truncated toward zero, and string fields receive numeric values
+// formatted in the shortest form. A value that does not fit its field's
+// type is reported in a *DecodeError.
+//
+// The fields of each struct type are computed once and reused. GetInto does
+// not use c.Cache or c.Extra.
+func (c *Client) GetInto(ctx context.Context, symbol string, interval Interval, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: got %T, want a non-nil pointer to a struct", ErrInvalidTarget, dst)
+	}
+	plan, err := planFor(v.Elem().Type())
+	if err != nil {
+		return err
+	}
+
+	symbol, err = normalizeSymbol(symbol)
+	if err != nil {
+		return err
+	}
+	dataInterval, err := c.intervalSuffix(interval)
+	if err != nil {
+		return err
+	}
+
+	responseMap, strs, err := c.getResponseMap(ctx, symbol, interval, plan.columns(dataInterval))
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, f := range plan.fields {
+		ok, err := setField(v.Elem().FieldByIndex(f.index), responseMap, strs, f.column(dataInterval))
+		if err != nil {
+			return err
+		}
+		if !ok && !slices.Contains(missing, f.name) {
+			missing = append(missing, f.name)
+		}
+	}
+
+	if c != nil && c.Strict && len(missing) > 0 {
+		return &MissingFieldsError{Symbol: symbol, Interval: interval, Fields: missing}
+	}
+	return nil
+}
+
+// plans caches the *intoPlan of each struct type passed to GetInto.
+var plans sync.Map // map[reflect.Type]*intoPlan
+
+// intoPlan lists the tagged fields of a struct type.
+type intoPlan struct {
+	fields []intoField
+}
+
+// intoField is a tagged field of a struct passed to GetInto.
+type intoField struct {
+	index  []int  // index sequence for reflect.Value.FieldByIndex
+	name   string // scanner field name without interval suffix
+	static bool   // whether the field is requested without suffix
+}
+
+// planFor returns the plan of struct type t, computing it on first use.
+func planFor(t reflect.Type) (*intoPlan, error) {
+	if plan, ok := plans.Load(t); ok {
+		return plan.(*intoPlan), nil
+	}
+	plan := &intoPlan{}
+	if err := plan.add(t, nil); err != nil {
+		return nil, err
+	}
+	actual, _ := plans.LoadOrStore(t, plan)
+	return actual.(*intoPlan), nil
+}
+
+// add appends the tagged fields of struct type t, whose index sequence is
+// prefix, to p.
+func (p *intoPlan) add(t reflect.Type, prefix []int) error {
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag, hasTag := sf.Tag.Lookup("tv")
+		if tag == "-" || !sf.IsExported() && !sf.Anonymous {
+			continue
+		}
+		index := append(slices.Clone(prefix), i)
+
+		if !hasTag {
+			if sf.Type.Kind() == reflect.Struct {
+				if err := p.add(sf.Type, index); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			return fmt.Errorf("%w: field %s.%s has an empty tv tag", ErrInvalidTarget, t, sf.Name)
+		}
+		if !supportedKind(sf.Type) {
+			return fmt.Errorf("%w: field %s.%s has unsupported type %s", ErrInvalidTarget, t, sf.Name, sf.Type)
+		}
+		p.fields = append(p.fields, intoField{
+			index:  index,
+			name:   name,
+			static: options == "static",
+		})
+	}
+	return nil
+}
+
+// columns returns the scanner columns of p at dataInterval.
+func (p *intoPlan) columns(dataInterval string) []string {
+	columns := make([]string, 0, len(p.fields))
+	for _, f := range p.fields {
+		if column := f.column(dataInterval); !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// column returns the scanner column of f at dataInterval.
+func (f intoField) column(dataInterval string) string {
+	if f.static {
+		return f.name
+	}
+	return f.name + dataInterval
+}
+
+// supportedKind reports whether a tagged field of type t can be set.
+func supportedKind(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+// setField stores the value of column in v and reports whether the response
+// had one.
+func setField(v reflect.Value, responseMap map[string]float64, strs map[string]string, column string) (bool, error) {
+	n, isNumber := responseMap[column]
+	s, isString := strs[column]
+	if !isNumber && !isString {
+		v.SetZero()
+		return false, nil
+	}
+
+	if v.Kind() == reflect.Pointer {
+		ptr := reflect.New(v.Type().Elem())
+		v.Set(ptr)
+		v = ptr.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		if !isString {
+			s = strconv.FormatFloat(n, 'f', -1, 64)
+		}
+		v.SetString(s)
+	default:
+		if !isNumber {
+			return false, &DecodeError{Snippet: s, Offset: -1, Err: fmt.Errorf("column %s: cannot decode string into %s", column, v.Type())}
+		}
+		if v.CanFloat() {
+			if v.OverflowFloat(n) {
+				return false, overflowError(column, n, v.Type())
+			}
+			v.SetFloat(n)
+		} else {
+			if math.IsNaN(n) || n < math.MinInt64 || n >= math.MaxInt64 || v.OverflowInt(int64(n)) {
+				return false, overflowError(column, n, v.Type())
+			}
+			v.SetInt(int64(n))
+		}
+	}
+	return true, nil
+}
+
+// overflowError reports that value n of column does not fit type t.
+func overflowError(column string, n float64, t reflect.Type) *DecodeError {
+	return &DecodeError{
+		Snippet: strconv.FormatFloat(n, 'g', -1, 64),
+		Offset:  -1,
+		Err:     fmt.Errorf("column %s: value overflows %s", column, t),
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type intoLevels struct {
+	R1 float64 `tv:"Pivot.M.Classic.R1"`
+	S1 float64 `tv:"Pivot.M.Classic.S1"`
+}
+
+type intoQuote struct {
+	Name   string   `tv:"description,static"`
+	Close  float64  `tv:"close"`
+	RSI    *float64 `tv:"RSI"`
+	EMA50  *float64 `tv:"EMA50"`
+	Volume int      `tv:"volume"`
+	Change string   `tv:"change"`
+	Pivots intoLevels
+	Note   string
+	Skip   float64 `tv:"-"`
+	hidden float64 `tv:"SMA10"`
+}
+
+func TestClient_GetInto(t *testing.T) {
+	var fields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields = strings.Split(r.URL.Query().Get("fields"), ",")
+		_, _ = w.Write([]byte(`{
+			"description": "Bitcoin / TetherUS",
+			"close|60": 70998.71,
+			"RSI|60": 55.5,
+			"EMA50|60": null,
+			"volume|60": 1234.9,
+			"change|60": -1.25,
+			"Pivot.M.Classic.R1|60": 72000
+		}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	emaWas := 1.0
+	dst := intoQuote{EMA50: &emaWas, Note: "kept", Pivots: intoLevels{S1: 5}}
+	if err := client.GetInto(context.Background(), "binance:btcusdt", Interval1Hour, &dst); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []string{
+		"description", "close|60", "RSI|60", "EMA50|60", "volume|60", "change|60",
+		"Pivot.M.Classic.R1|60", "Pivot.M.Classic.S1|60",
+	}
+	if !slices.Equal(fields, want) {
+		t.Fatalf("requested fields %v, want %v", fields, want)
+	}
+
+	if dst.Name != "Bitcoin / TetherUS" || dst.Close != 70998.71 || dst.Volume != 1234 || dst.Change != "-1.25" {
+		t.Fatalf("unexpected values: %+v", dst)
+	}
+	if dst.RSI == nil || *dst.RSI != 55.5 || dst.EMA50 != nil {
+		t.Fatalf("unexpected pointer values: RSI %v, EMA50 %v", dst.RSI, dst.EMA50)
+	}
+	if dst.Pivots.R1 != 72000 || dst.Pivots.S1 != 0 || dst.Note != "kept" {
+		t.Fatalf("unexpected nested values: %+v", dst)
+	}
+}
+
+func TestClient_GetIntoStrict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"close": 1}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Strict:     true,
+	}
+
+	var dst intoLevels
+	err := client.GetInto(context.Background(), "BINANCE:BTCUSDT", Interval1Day, &dst)
+
+	var missingErr *MissingFieldsError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("expected *MissingFieldsError, got %v", err)
+	}
+	if !slices.Equal(missingErr.Fields, []string{"Pivot.M.Classic.R1", "Pivot.M.Classic.S1"}) {
+		t.Fatalf("unexpected missing fields: %v", missingErr.Fields)
+	}
+}
+
+func TestClient_GetIntoStringIntoNumber(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"description": "Bitcoin"}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	var dst struct {
+		Name float64 `tv:"description,static"`
+	}
+	var decodeErr *DecodeError
+	if err := client.GetInto(context.Background(), "BINANCE:BTCUSDT", "", &dst); !errors.As(err, &decodeErr) {
+		t.Fatalf("expected *DecodeError, got %v", err)
+	}
+}
+
+func TestClient_GetIntoOverflow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"volume": 1e12, "close": 1e300, "open": 127.9}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	var small struct {
+		Open int8 `tv:"open,static"`
+	}
+	if err := client.GetInto(context.Background(), "BINANCE:BTCUSDT", "", &small); err != nil || small.Open != 127 {
+		t.Fatalf("GetInto = %v, Open = %d, want 127", err, small.Open)
+	}
+
+	tests := []struct {
+		name   string
+		dst    any
+		column string
+	}{
+		{name: "int32", dst: &struct {
+			Volume int32 `tv:"volume,static"`
+		}{}, column: "volume"},
+		{name: "int64", dst: &struct {
+			Close *int64 `tv:"close,static"`
+		}{}, column: "close"},
+		{name: "float32", dst: &struct {
+			Close float32 `tv:"close,static"`
+		}{}, column: "close"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.GetInto(context.Background(), "BINANCE:BTCUSDT", "", tt.dst)
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("expected *DecodeError, got %v", err)
+			}
+			if !strings.Contains(err.Error(), "column "+tt.column+":") {
+				t.Fatalf("expected column %s in %q", tt.column, err.Error())
+			}
+		})
+	}
+}
+
+func TestClient_GetIntoInvalidTarget(t *testing.T) {
+	var client Client
+	var nilQuote *intoQuote
+	var unsupported struct {
+		Values []float64 `tv:"RSI"`
+	}
+	var emptyTag struct {
+		RSI float64 `tv:",static"`
+	}
+
+	for _, dst := range []any{nil, intoQuote{}, nilQuote, new(int), &unsupported, &emptyTag} {
+		if err := client.GetInto(context.Background(), "BINANCE:BTCUSDT", "", dst); !errors.Is(err, ErrInvalidTarget) {
+			t.Fatalf("GetInto(%T): expected ErrInvalidTarget, got %v", dst, err)
+		}
+	}
+}
+
+func Test_planFor(t *testing.T) {
+	typ := reflect.TypeFor[intoQuote]()
+	plan, err := planFor(typ)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	again, err := planFor(typ)
+	if err != nil || again != plan {
+		t.Fatal("expected the plan to be cached")
+	}
+
+	if len(plan.fields) != 8 {
+		t.Fatalf("expected 8 fields, got %+v", plan.fields)
+	}
+	last := plan.fields[len(plan.fields)-1]
+	if last.name != "Pivot.M.Classic.S1" || !slices.Equal(last.index, []int{6, 1}) {
+		t.Fatalf("unexpected nested field: %+v", last)
+	}
+	if got := plan.columns(""); got[0] != "description" || got[1] != "close" {
+		t.Fatalf("unexpected daily columns: %v", got)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func newMissingServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{
+			"Recommend.All|60": 0,
+			"EMA10|60": 100,
+			"EMA200|60": null,
+			"RSI|60": 25,
+			"close|60": 101
+		}`)
+	}))
+}
+
+func TestTradingView_Missing(t *testing.T) {
+	server := newMissingServer(t)
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	ta := &TradingView{}
+	if err := client.Get(ta, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, field := range []string{"Recommend.All", "EMA10", "RSI", "close"} {
+		if !ta.IsSet(field) {
+			t.Errorf("expected %s to be set", field)
+		}
+		if slices.Contains(ta.Missing, field) {
+			t.Errorf("did not expect %s in Missing", field)
+		}
+	}
+	for _, field := range []string{"EMA200", "RSI[1]", "Pivot.M.Classic.R1"} {
+		if ta.IsSet(field) {
+			t.Errorf("expected %s to be unset", field)
+		}
+		if !slices.Contains(ta.Missing, field) {
+			t.Errorf("expected %s in Missing", field)
+		}
+	}
+
+	if ta.Recommend.MovingAverages.EMA10 != SignalBuy {
+		t.Fatalf("unexpected EMA10 recommendation: %v", ta.Recommend.MovingAverages.EMA10)
+	}
+	if ta.Recommend.MovingAverages.EMA200 != SignalNeutral {
+		t.Fatalf("expected neutral EMA200 recommendation for null value, got %v", ta.Recommend.MovingAverages.EMA200)
+	}
+	if ta.Recommend.MovingAverages.SMA200 != SignalNeutral {
+		t.Fatalf("expected neutral SMA200 recommendation for absent value, got %v", ta.Recommend.MovingAverages.SMA200)
+	}
+	if ta.Recommend.Oscillators.RSI != SignalNeutral {
+		t.Fatalf("expected neutral RSI recommendation without RSI[1], got %v", ta.Recommend.Oscillators.RSI)
+	}
+}
+
+func TestTradingView_MissingResetOnReuse(t *testing.T) {
+	ta := &TradingView{}
+	ta.populate(map[string]float64{"EMA200": 1, "close": 2}, "", nil)
+	if ta.Recommend.MovingAverages.EMA200 != SignalBuy {
+		t.Fatalf("unexpected EMA200 recommendation: %v", ta.Recommend.MovingAverages.EMA200)
+	}
+
+	ta.populate(map[string]float64{"close": 2}, "", nil)
+	if ta.Recommend.MovingAverages.EMA200 != SignalNeutral || ta.IsSet("EMA200") {
+		t.Fatal("expected EMA200 to be reset when reusing TradingView")
+	}
+}
+
+func TestTradingView_IsSetNil(t *testing.T) {
+	var ta *TradingView
+	if ta.IsSet("close") {
+		t.Fatal("expected nil TradingView to report no fields")
+	}
+}
+
+func TestClient_GetStrict(t *testing.T) {
+	server := newMissingServer(t)
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Strict:     true,
+	}
+
+	ta := &TradingView{}
+	err := client.Get(ta, "BINANCE:BTCUSDT", Interval1Hour)
+	if !errors.Is(err, ErrMissingFields) {
+		t.Fatalf("expected ErrMissingFields, got %v", err)
+	}
+
+	var missingErr *MissingFieldsError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("expected *MissingFieldsError, got %T", err)
+	}
+	if missingErr.Symbol != "BINANCE:BTCUSDT" || missingErr.Interval != Interval1Hour {
+		t.Fatalf("unexpected error context: %+v", missingErr)
+	}
+	if !slices.Contains(missingErr.Fields, "EMA200") || slices.Contains(missingErr.Fields, "EMA10") {
+		t.Fatalf("unexpected missing fields: %v", missingErr.Fields)
+	}
+	if ta.Value.Prices.Close != 101 {
+		t.Fatalf("expected result to be populated in strict mode, got close %v", ta.Value.Prices.Close)
+	}
+}
+
+func TestClient_GetIntervalsStrict(t *testing.T) {
+	server := newMissingServer(t)
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Strict:     true,
+	}
+
+	results, err := client.GetIntervals("BINANCE:BTCUSDT", Interval1Hour)
+	if !errors.Is(err, ErrMissingFields) {
+		t.Fatalf("expected ErrMissingFields, got %v", err)
+	}
+	if results[Interval1Hour] == nil {
+		t.Fatal("expected result to be returned in strict mode")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that limits how often requests are sent.
+//
+// The bucket holds up to Burst tokens and refills at Rate tokens per
+// second. Each request takes one token and waits when none is left. A
+// RateLimiter is safe for concurrent use and may be shared by several
+// Client values to limit them together. The zero value does not limit.
+type RateLimiter struct {
+	// Rate is the number of requests allowed per second. Zero or less
+	// disables limiting.
+	Rate float64
+	// Burst is the number of requests that may be sent at once. Zero or
+	// less means 1.
+	Burst int
+	// Clock is used to tell time and wait. Nil means SystemClock.
+	Clock Clock
+	// OnWait, if set, is called with the delay whenever a request has to
+	// wait for a token, before waiting. It must not block.
+	OnWait func(d time.Duration)
+
+	mu      sync.Mutex
+	started bool
+	tokens  float64
+	last    time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that allows rate requests per second
+// with bursts of up to burst requests.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{Rate: rate, Burst: burst}
+}
+
+// Wait takes a token, waiting until one is available or ctx is done. It
+// returns ctx.Err() if ctx is done first, in which case no token is used.
+// Wait on a nil RateLimiter returns immediately.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if l == nil || l.Rate <= 0 {
+		return nil
+	}
+
+	clock := clockOrSystem(l.Clock)
+	d := l.reserve(clock.Now())
+	if d <= 0 {
+		return nil
+	}
+
+	if l.OnWait != nil {
+		l.OnWait(d)
+	}
+	if err := clock.Sleep(ctx, d); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token at now and returns how long the caller must wait
+// before using it.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	burst := float64(l.burst())
+	switch {
+	case !l.started:
+		l.started = true
+		l.tokens = burst
+		l.last = now
+	case now.After(l.last):
+		l.tokens = min(burst, l.tokens+now.Sub(l.last).Seconds()*l.Rate)
+		l.last = now
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.Rate * float64(time.Second))
+}
+
+// cancel returns a token taken by reserve that will not be used.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(float64(l.burst()), l.tokens+1)
+}
+
+func (l *RateLimiter) burst() int {
+	if l.Burst <= 0 {
+		return 1
+	}
+	return l.Burst
+}
@@ -0,0 +1,162 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	clock := newFakeClock()
+	var waits []time.Duration
+	limiter := &RateLimiter{
+		Rate:   2,
+		Burst:  2,
+		Clock:  clock,
+		OnWait: func(d time.Duration) { waits = append(waits, d) },
+	}
+
+	for range 4 {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+
+	want := []time.Duration{500 * time.Millisecond, 500 * time.Millisecond}
+	if got := clock.Sleeps(); !slices.Equal(got, want) {
+		t.Fatalf("unexpected sleeps: %v", got)
+	}
+	if !slices.Equal(waits, want) {
+		t.Fatalf("unexpected OnWait delays: %v", waits)
+	}
+}
+
+func TestRateLimiter_WaitRefills(t *testing.T) {
+	clock := newFakeClock()
+	limiter := &RateLimiter{Rate: 1, Burst: 3, Clock: clock}
+
+	for range 3 {
+		_ = limiter.Wait(context.Background())
+	}
+	clock.now = clock.now.Add(10 * time.Second)
+	for range 3 {
+		_ = limiter.Wait(context.Background())
+	}
+	if got := clock.Sleeps(); len(got) != 0 {
+		t.Fatalf("expected the bucket to refill up to Burst, got sleeps %v", got)
+	}
+
+	_ = limiter.Wait(context.Background())
+	if got := clock.Sleeps(); len(got) != 1 || got[0] != time.Second {
+		t.Fatalf("unexpected sleeps: %v", got)
+	}
+}
+
+func TestRateLimiter_WaitCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	limiter := &RateLimiter{Rate: 1, Clock: cancelClock{cancel: cancel}}
+
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("expected first token to be free, got %v", err)
+	}
+	if err := limiter.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if limiter.tokens != 0 {
+		t.Fatalf("expected canceled wait to return its token, have %v tokens", limiter.tokens)
+	}
+}
+
+func TestRateLimiter_WaitUnlimited(t *testing.T) {
+	var nilLimiter *RateLimiter
+	if err := nilLimiter.Wait(context.Background()); err != nil {
+		t.Fatalf("nil limiter: %v", err)
+	}
+
+	clock := newFakeClock()
+	zero := &RateLimiter{Clock: clock}
+	for range 10 {
+		if err := zero.Wait(context.Background()); err != nil {
+			t.Fatalf("zero limiter: %v", err)
+		}
+	}
+	if len(clock.Sleeps()) != 0 {
+		t.Fatalf("zero limiter should not wait, got %v", clock.Sleeps())
+	}
+}
+
+func TestRateLimiter_WaitConcurrent(t *testing.T) {
+	clock := newFakeClock()
+	limiter := NewRateLimiter(10, 5)
+	limiter.Clock = clock
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = limiter.Wait(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	if got := len(clock.Sleeps()); got < 1 || got > 15 {
+		t.Fatalf("expected between 1 and 15 waits, got %d", got)
+	}
+}
+
+func TestClient_RateLimiterShared(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		_, _ = io.WriteString(w, `{"close": 1}`)
+	}))
+	defer server.Close()
+
+	clock := newFakeClock()
+	limiter := &RateLimiter{Rate: 1, Clock: clock}
+	first := Client{HTTPClient: server.Client(), BaseURL: server.URL, RateLimiter: limiter}
+	second := Client{HTTPClient: server.Client(), BaseURL: server.URL, RateLimiter: limiter}
+
+	for _, client := range []*Client{&first, &second, &first} {
+		if err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", Interval1Day); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+	want := []time.Duration{time.Second, time.Second}
+	if got := clock.Sleeps(); !slices.Equal(got, want) {
+		t.Fatalf("unexpected sleeps: %v", got)
+	}
+}
+
+func TestClient_RateLimiterCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := Client{HTTPClient: server.Client(), BaseURL: server.URL, RateLimiter: NewRateLimiter(1, 1)}
+	err := client.GetContext(ctx, &TradingView{}, "BINANCE:BTCUSDT", Interval1Day)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
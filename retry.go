@@ -0,0 +1,157 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"slices"
+	"time"
+)
+
+// Default values used by RetryPolicy for zero fields.
+const (
+	DefaultRetryAttempts  = 3
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	DefaultRetryMaxDelay  = 30 * time.Second
+)
+
+// DefaultRetryStatusCodes lists the HTTP status codes retried when
+// RetryPolicy.StatusCodes is nil.
+var DefaultRetryStatusCodes = []int{429, 500, 502, 503, 504}
+
+// RetryPolicy retries failed requests with exponential backoff.
+//
+// The delay before attempt n+1 is BaseDelay * 2^(n-1), capped at MaxDelay
+// and reduced by up to Jitter of itself at random. If the response carries
+// a Retry-After header, the delay is at least that long.
+//
+// The zero value retries up to DefaultRetryAttempts times in total, on the
+// DefaultRetryStatusCodes and on network errors.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Zero means DefaultRetryAttempts; 1 disables retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the second attempt. Zero means
+	// DefaultRetryBaseDelay.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay. Zero means DefaultRetryMaxDelay.
+	MaxDelay time.Duration
+	// Jitter is the fraction, between 0 and 1, by which each delay may be
+	// randomly shortened. Zero disables jitter.
+	Jitter float64
+	// StatusCodes lists the retryable HTTP status codes. Nil means
+	// DefaultRetryStatusCodes.
+	StatusCodes []int
+	// Retryable, if set, reports whether err should be retried, replacing
+	// the StatusCodes and network error rules.
+	Retryable func(err error) bool
+	// Clock is used to wait between attempts. Nil means SystemClock.
+	Clock Clock
+
+	random func() float64 // returns a value in [0, 1); nil uses math/rand
+}
+
+// RetryError is returned by clients with a RetryPolicy when a request
+// fails. It records how many attempts were made.
+type RetryError struct {
+	// Attempts is the number of attempts made.
+	Attempts int
+	// Err is the error from the last attempt.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *RetryError) Error() string {
+	if e.Attempts == 1 {
+		return fmt.Sprintf("%v (1 attempt)", e.Err)
+	}
+	return fmt.Sprintf("%v (%d attempts)", e.Err, e.Attempts)
+}
+
+// Unwrap returns the error from the last attempt.
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// run calls attempt until it succeeds, fails with an error that is not
+// retryable, the attempts are used up, or ctx is done.
+func (p *RetryPolicy) run(ctx context.Context, attempt func() ([]byte, error)) ([]byte, error) {
+	clock := clockOrSystem(p.Clock)
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultRetryAttempts
+	}
+
+	for n := 1; ; n++ {
+		body, err := attempt()
+		if err == nil {
+			return body, nil
+		}
+		if n >= maxAttempts || ctx.Err() != nil || !p.retryable(err) {
+			return nil, &RetryError{Attempts: n, Err: err}
+		}
+
+		if sleepErr := clock.Sleep(ctx, p.delay(n, err)); sleepErr != nil {
+			return nil, &RetryError{Attempts: n, Err: contextError(ctx, err)}
+		}
+	}
+}
+
+// retryable reports whether err should be retried.
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	if isContextError(err) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		codes := p.StatusCodes
+		if codes == nil {
+			codes = DefaultRetryStatusCodes
+		}
+		return slices.Contains(codes, apiErr.StatusCode)
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// delay returns how long to wait after attempt n failed with err.
+func (p *RetryPolicy) delay(n int, err error) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = DefaultRetryBaseDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultRetryMaxDelay
+	}
+
+	d := maxDelay
+	if shift := n - 1; shift < 62 && base <= maxDelay>>shift {
+		d = base << shift
+	}
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		random := p.random
+		if random == nil {
+			random = rand.Float64
+		}
+		d -= time.Duration(jitter * random() * float64(d))
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > d {
+		d = apiErr.RetryAfter
+	}
+	return d
+}
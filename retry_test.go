@@ -0,0 +1,372 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock that records sleeps and advances instantly.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func (c *fakeClock) Sleeps() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]time.Duration(nil), c.sleeps...)
+}
+
+// flakyServer fails with the given statuses before answering 200 OK.
+func flakyServer(t *testing.T, statuses ...int) (*httptest.Server, *int) {
+	t.Helper()
+	var mu sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		n := calls
+		calls++
+		mu.Unlock()
+
+		if n < len(statuses) {
+			if statuses[n] == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "5")
+			}
+			http.Error(w, "failure", statuses[n])
+			return
+		}
+		_, _ = io.WriteString(w, `{"close|60": 1}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestClient_GetRetry(t *testing.T) {
+	server, calls := flakyServer(t, http.StatusBadGateway, http.StatusServiceUnavailable)
+	clock := newFakeClock()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Retry: &RetryPolicy{
+			BaseDelay: time.Second,
+			Clock:     clock,
+		},
+	}
+
+	ta := &TradingView{}
+	if err := client.Get(ta, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", *calls)
+	}
+	if got := clock.Sleeps(); len(got) != 2 || got[0] != time.Second || got[1] != 2*time.Second {
+		t.Fatalf("unexpected sleeps: %v", got)
+	}
+	if ta.Value.Prices.Close != 1 {
+		t.Fatalf("unexpected close price: %v", ta.Value.Prices.Close)
+	}
+}
+
+func TestClient_GetRetryExhausted(t *testing.T) {
+	server, calls := flakyServer(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+	clock := newFakeClock()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Retry: &RetryPolicy{
+			MaxAttempts: 3,
+			Clock:       clock,
+		},
+	}
+
+	err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", Interval1Hour)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 3 {
+		t.Fatalf("expected *RetryError after 3 attempts, got %v", err)
+	}
+	if !errors.Is(err, ErrServerError) {
+		t.Fatalf("expected ErrServerError, got %v", err)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", *calls)
+	}
+	if got := clock.Sleeps(); len(got) != 2 || got[0] != DefaultRetryBaseDelay || got[1] != 2*DefaultRetryBaseDelay {
+		t.Fatalf("unexpected sleeps: %v", got)
+	}
+}
+
+func TestClient_GetRetryAfter(t *testing.T) {
+	server, _ := flakyServer(t, http.StatusTooManyRequests)
+	clock := newFakeClock()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Retry:      &RetryPolicy{Clock: clock},
+	}
+
+	if err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := clock.Sleeps(); len(got) != 1 || got[0] != 5*time.Second {
+		t.Fatalf("expected Retry-After delay, got %v", got)
+	}
+}
+
+func TestClient_GetRetryAfterDate(t *testing.T) {
+	clock := newFakeClock()
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", clock.Now().Add(7*time.Second).Format(http.TimeFormat))
+			http.Error(w, "slow down", http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"close|60": 1}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Retry:      &RetryPolicy{Clock: clock},
+	}
+
+	if err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := clock.Sleeps(); len(got) != 1 || got[0] != 7*time.Second {
+		t.Fatalf("expected the Retry-After date to use the policy clock, got %v", got)
+	}
+}
+
+func TestClient_GetRetryNotRetryable(t *testing.T) {
+	server, calls := flakyServer(t, http.StatusNotFound)
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Retry:      &RetryPolicy{Clock: newFakeClock()},
+	}
+
+	err := client.Get(&TradingView{}, "BINANCE:NOPE", Interval1Hour)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 1 {
+		t.Fatalf("expected *RetryError after 1 attempt, got %v", err)
+	}
+	if !errors.Is(err, ErrSymbolNotFound) {
+		t.Fatalf("expected ErrSymbolNotFound, got %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestClient_GetRetryCustomStatusCodes(t *testing.T) {
+	server, calls := flakyServer(t, http.StatusNotFound)
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Retry: &RetryPolicy{
+			StatusCodes: []int{http.StatusNotFound},
+			Clock:       newFakeClock(),
+		},
+	}
+
+	if err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if *calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestClient_GetRetryNetworkError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	clock := newFakeClock()
+
+	client := Client{
+		BaseURL: server.URL,
+		Retry: &RetryPolicy{
+			MaxAttempts: 2,
+			Clock:       clock,
+		},
+	}
+
+	err := client.Get(&TradingView{}, "BINANCE:BTCUSDT", Interval1Hour)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 2 {
+		t.Fatalf("expected *RetryError after 2 attempts, got %v", err)
+	}
+	if len(clock.Sleeps()) != 1 {
+		t.Fatalf("expected network error to be retried, got sleeps %v", clock.Sleeps())
+	}
+}
+
+func TestClient_GetRetryContextCanceled(t *testing.T) {
+	server, calls := flakyServer(t, http.StatusBadGateway, http.StatusBadGateway)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		Retry: &RetryPolicy{
+			Clock: cancelClock{cancel: cancel},
+		},
+	}
+
+	err := client.GetContext(ctx, &TradingView{}, "BINANCE:BTCUSDT", Interval1Hour)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if !errors.Is(err, ErrServerError) {
+		t.Fatalf("expected last attempt error to be kept, got %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
+
+// cancelClock cancels its context when asked to sleep.
+type cancelClock struct {
+	cancel context.CancelFunc
+}
+
+func (c cancelClock) Now() time.Time { return time.Time{} }
+
+func (c cancelClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.cancel()
+	return ctx.Err()
+}
+
+func TestClient_GetManyRetryResendsBody(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	handler := scanHandler(t, map[string]map[string]any{
+		"BINANCE:BTCUSDT": {"close": 1},
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		first := calls == 1
+		mu.Unlock()
+		if first {
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+			return
+		}
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+		Retry:       &RetryPolicy{Clock: newFakeClock()},
+	}
+
+	results, err := client.GetMany(context.Background(), []string{"BINANCE:BTCUSDT"}, Interval1Day)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if calls != 2 || results["BINANCE:BTCUSDT"].Value.Prices.Close != 1 {
+		t.Fatalf("unexpected results after %d calls: %v", calls, results)
+	}
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		n      int
+		err    error
+		want   time.Duration
+	}{
+		{name: "first", policy: RetryPolicy{BaseDelay: time.Second}, n: 1, want: time.Second},
+		{name: "third", policy: RetryPolicy{BaseDelay: time.Second}, n: 3, want: 4 * time.Second},
+		{name: "capped", policy: RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}, n: 4, want: 5 * time.Second},
+		{name: "overflow", policy: RetryPolicy{BaseDelay: time.Second}, n: 100, want: DefaultRetryMaxDelay},
+		{name: "jitter", policy: RetryPolicy{BaseDelay: time.Second, Jitter: 0.5, random: func() float64 { return 1 }}, n: 1, want: 500 * time.Millisecond},
+		{name: "jitter clamped", policy: RetryPolicy{BaseDelay: time.Second, Jitter: 3, random: func() float64 { return 0.5 }}, n: 1, want: 500 * time.Millisecond},
+		{name: "retry after", policy: RetryPolicy{BaseDelay: time.Second}, n: 1, err: &APIError{RetryAfter: time.Minute}, want: time.Minute},
+		{name: "retry after shorter", policy: RetryPolicy{BaseDelay: time.Second}, n: 3, err: &APIError{RetryAfter: time.Second}, want: 4 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.err
+			if err == nil {
+				err = errors.New("boom")
+			}
+			if got := tt.policy.delay(tt.n, err); got != tt.want {
+				t.Fatalf("delay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_retryable(t *testing.T) {
+	custom := RetryPolicy{Retryable: func(err error) bool { return err.Error() == "again" }}
+	if !custom.retryable(errors.New("again")) || custom.retryable(&APIError{StatusCode: 503}) {
+		t.Fatal("expected Retryable to replace the default rules")
+	}
+
+	var policy RetryPolicy
+	if policy.retryable(errors.New("boom")) {
+		t.Fatal("did not expect plain errors to be retryable")
+	}
+	if policy.retryable(context.DeadlineExceeded) {
+		t.Fatal("did not expect context errors to be retryable")
+	}
+	if !policy.retryable(io.ErrUnexpectedEOF) {
+		t.Fatal("expected truncated responses to be retryable")
+	}
+}
+
+func TestRetryError_Error(t *testing.T) {
+	if got := (&RetryError{Attempts: 1, Err: errors.New("boom")}).Error(); got != "boom (1 attempt)" {
+		t.Fatalf("Error() = %q", got)
+	}
+	if got := (&RetryError{Attempts: 3, Err: errors.New("boom")}).Error(); got != "boom (3 attempts)" {
+		t.Fatalf("Error() = %q", got)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import "maps"
+
+// RuleSet decides how indicator values become recommendation signals.
+//
+// Thresholds are used as given, so zero is a valid threshold; start from
+// DefaultRules and change the thresholds you need. A rule function, when
+// set, replaces the threshold rule for its indicator entirely. A nil
+// *RuleSet behaves as DefaultRules.
+type RuleSet struct {
+	// RSIOversold and RSIOverbought bound the RSI rule: buy below
+	// RSIOversold while rising, sell above RSIOverbought while falling.
+	RSIOversold, RSIOverbought float64
+	// StochOversold and StochOverbought bound the Stochastic rule: buy when
+	// %K and %D are below StochOversold and %K crosses above %D, sell when
+	// both are above StochOverbought and %K crosses below %D.
+	StochOversold, StochOverbought float64
+	// CCIOversold and CCIOverbought bound the CCI rule: buy below
+	// CCIOversold while rising, sell above CCIOverbought while falling.
+	CCIOversold, CCIOverbought float64
+	// ADXTrend is the ADX level above which a +DI/-DI cross is a signal.
+	ADXTrend float64
+	// StrongSell, Sell, Buy and StrongBuy are the band edges for the
+	// aggregate Recommend scores between -1 and 1: scores below StrongSell
+	// are STRONG_SELL, below Sell SELL, up to Buy NEUTRAL, up to StrongBuy
+	// BUY, and higher scores STRONG_BUY.
+	StrongSell, Sell, Buy, StrongBuy float64
+
+	// RSI, if set, rates the RSI and its previous value.
+	RSI func(rsi, rsi1 float64) Signal
+	// Stoch, if set, rates Stochastic %K and %D and their previous values.
+	Stoch func(k, d, k1, d1 float64) Signal
+	// CCI, if set, rates the CCI and its previous value.
+	CCI func(cci, cci1 float64) Signal
+	// ADX, if set, rates the ADX with the current and previous +DI and -DI.
+	ADX func(adx, plusDI, minusDI, plusDI1, minusDI1 float64) Signal
+	// AO, if set, rates the Awesome Oscillator and its two previous values.
+	AO func(ao, ao1, ao2 float64) Signal
+	// Mom, if set, rates Momentum and its previous value.
+	Mom func(mom, mom1 float64) Signal
+	// MACD, if set, rates the MACD line and its signal line.
+	MACD func(macd, signal float64) Signal
+	// MA, if set, rates each EMA and SMA against the close.
+	MA func(ma, close float64) Signal
+	// Simple, if set, rates the scanner's own ratings, between -1 and 1,
+	// for Stochastic RSI, Williams %R, Bull Bear Power, Ultimate
+	// Oscillator, Ichimoku, VWMA and Hull MA.
+	Simple func(rating float64) Signal
+	// Recommend, if set, rates the aggregate Recommend scores.
+	Recommend func(score float64) Signal
+}
+
+// DefaultRules returns the rules TradingView uses, which apply when a
+// Client has no Rules. Each call returns a new copy to modify:
+//
+//	rules := tradingview.DefaultRules()
+//	rules.RSIOversold, rules.RSIOverbought = 25, 75
+//	client.Rules = &rules
+func DefaultRules() RuleSet {
+	return defaultRules
+}
+
+var defaultRules = RuleSet{
+	RSIOversold:     30,
+	RSIOverbought:   70,
+	StochOversold:   20,
+	StochOverbought: 80,
+	CCIOversold:     -100,
+	CCIOverbought:   100,
+	ADXTrend:        20,
+	StrongSell:      -0.5,
+	Sell:            -0.1,
+	Buy:             0.1,
+	StrongBuy:       0.5,
+}
+
+// Recompute derives Recommend again from the values in ta using rules, or
+// DefaultRules if rules is nil, without a new request.
+//
+// Values are taken from ta.Value, so changes to it are taken into account.
+// Inputs that Value does not hold, such as previous-bar values and the
+// scanner's own ratings, are taken from Raw. A field missing from the
+// response counts as present once its Value is set to a non-zero value.
+// Missing and Raw are updated accordingly; extra fields and the Symbol,
+// Interval and FetchedAt metadata are kept.
+func (ta *TradingView) Recompute(rules *RuleSet) {
+	if ta == nil {
+		return
+	}
+	fields := maps.Clone(ta.Raw)
+	if fields == nil {
+		fields = make(map[string]float64)
+	}
+	for field, v := range ta.Value.fieldPointers() {
+		if _, ok := fields[field]; ok || *v != 0 {
+			fields[field] = *v
+		}
+	}
+
+	source := *ta
+	ta.populate(fields, "", rules)
+	ta.Symbol, ta.Interval, ta.FetchedAt = source.Symbol, source.Interval, source.FetchedAt
+	ta.Strings = source.Strings
+	for field, v := range fields {
+		if !defaultFields[field] {
+			ta.Raw[field] = v
+		}
+	}
+	for _, field := range source.Missing {
+		if !defaultFields[field] {
+			ta.Missing = append(ta.Missing, field)
+		}
+	}
+}
+
+// fieldPointers maps scanner field names without interval suffix to the
+// Values fields that hold them.
+func (v *Values) fieldPointers() map[string]*float64 {
+	return map[string]*float64{
+		"Recommend.All":   &v.Global.Summary,
+		"Recommend.Other": &v.Global.Oscillators,
+		"Recommend.MA":    &v.Global.MA,
+
+		"RSI":         &v.Oscillators.RSI,
+		"Stoch.K":     &v.Oscillators.StochK,
+		"CCI20":       &v.Oscillators.CCI,
+		"ADX":         &v.Oscillators.ADX.Value,
+		"ADX+DI":      &v.Oscillators.ADX.PlusDI,
+		"ADX-DI":      &v.Oscillators.ADX.MinusDI,
+		"ADX+DI[1]":   &v.Oscillators.ADX.PlusDI1,
+		"ADX-DI[1]":   &v.Oscillators.ADX.MinusDI1,
+		"AO":          &v.Oscillators.AO.Value,
+		"AO[1]":       &v.Oscillators.AO.Prev1,
+		"AO[2]":       &v.Oscillators.AO.Prev2,
+		"Mom":         &v.Oscillators.Mom,
+		"MACD.macd":   &v.Oscillators.MACD.Macd,
+		"MACD.signal": &v.Oscillators.MACD.Signal,
+		"Stoch.RSI.K": &v.Oscillators.StochRSI,
+		"W.R":         &v.Oscillators.WR,
+		"BBPower":     &v.Oscillators.BBP,
+		"UO":          &v.Oscillators.UO,
+
+		"EMA10":          &v.MovingAverages.EMA10,
+		"SMA10":          &v.MovingAverages.SMA10,
+		"EMA20":          &v.MovingAverages.EMA20,
+		"SMA20":          &v.MovingAverages.SMA20,
+		"EMA30":          &v.MovingAverages.EMA30,
+		"SMA30":          &v.MovingAverages.SMA30,
+		"EMA50":          &v.MovingAverages.EMA50,
+		"SMA50":          &v.MovingAverages.SMA50,
+		"EMA100":         &v.MovingAverages.EMA100,
+		"SMA100":         &v.MovingAverages.SMA100,
+		"EMA200":         &v.MovingAverages.EMA200,
+		"SMA200":         &v.MovingAverages.SMA200,
+		"Ichimoku.BLine": &v.MovingAverages.Ichimoku,
+		"VWMA":           &v.MovingAverages.VWMA,
+		"HullMA9":        &v.MovingAverages.HullMA,
+
+		"Pivot.M.Classic.Middle":   &v.Pivots.Classic.Middle,
+		"Pivot.M.Classic.R1":       &v.Pivots.Classic.R1,
+		"Pivot.M.Classic.R2":       &v.Pivots.Classic.R2,
+		"Pivot.M.Classic.R3":       &v.Pivots.Classic.R3,
+		"Pivot.M.Classic.S1":       &v.Pivots.Classic.S1,
+		"Pivot.M.Classic.S2":       &v.Pivots.Classic.S2,
+		"Pivot.M.Classic.S3":       &v.Pivots.Classic.S3,
+		"Pivot.M.Fibonacci.Middle": &v.Pivots.Fibonacci.Middle,
+		"Pivot.M.Fibonacci.R1":     &v.Pivots.Fibonacci.R1,
+		"Pivot.M.Fibonacci.R2":     &v.Pivots.Fibonacci.R2,
+		"Pivot.M.Fibonacci.R3":     &v.Pivots.Fibonacci.R3,
+		"Pivot.M.Fibonacci.S1":     &v.Pivots.Fibonacci.S1,
+		"Pivot.M.Fibonacci.S2":     &v.Pivots.Fibonacci.S2,
+		"Pivot.M.Fibonacci.S3":     &v.Pivots.Fibonacci.S3,
+		"Pivot.M.Camarilla.Middle": &v.Pivots.Camarilla.Middle,
+		"Pivot.M.Camarilla.R1":     &v.Pivots.Camarilla.R1,
+		"Pivot.M.Camarilla.R2":     &v.Pivots.Camarilla.R2,
+		"Pivot.M.Camarilla.R3":     &v.Pivots.Camarilla.R3,
+		"Pivot.M.Camarilla.S1":     &v.Pivots.Camarilla.S1,
+		"Pivot.M.Camarilla.S2":     &v.Pivots.Camarilla.S2,
+		"Pivot.M.Camarilla.S3":     &v.Pivots.Camarilla.S3,
+		"Pivot.M.Woodie.Middle":    &v.Pivots.Woodie.Middle,
+		"Pivot.M.Woodie.R1":        &v.Pivots.Woodie.R1,
+		"Pivot.M.Woodie.R2":        &v.Pivots.Woodie.R2,
+		"Pivot.M.Woodie.R3":        &v.Pivots.Woodie.R3,
+		"Pivot.M.Woodie.S1":        &v.Pivots.Woodie.S1,
+		"Pivot.M.Woodie.S2":        &v.Pivots.Woodie.S2,
+		"Pivot.M.Woodie.S3":        &v.Pivots.Woodie.S3,
+		"Pivot.M.Demark.Middle":    &v.Pivots.Demark.Middle,
+		"Pivot.M.Demark.R1":        &v.Pivots.Demark.R1,
+		"Pivot.M.Demark.S1":        &v.Pivots.Demark.S1,
+
+		"close": &v.Prices.Close,
+		"high":  &v.Prices.High,
+		"low":   &v.Prices.Low,
+	}
+}
+
+// rules returns the rules of c, or nil for DefaultRules.
+func (c *Client) rules() *RuleSet {
+	if c == nil {
+		return nil
+	}
+	return c.Rules
+}
+
+// orDefault returns r, or DefaultRules if r is nil.
+func (r *RuleSet) orDefault() *RuleSet {
+	if r == nil {
+		return &defaultRules
+	}
+	return r
+}
+
+func (r *RuleSet) recommend(score float64) Signal {
+	if r = r.orDefault(); r.Recommend != nil {
+		return r.Recommend(score)
+	}
+	return computeRecommend(score, r.StrongSell, r.Sell, r.Buy, r.StrongBuy)
+}
+
+func (r *RuleSet) rsi(rsi, rsi1 float64) Signal {
+	if r = r.orDefault(); r.RSI != nil {
+		return r.RSI(rsi, rsi1)
+	}
+	return rsiRule(rsi, rsi1, r.RSIOversold, r.RSIOverbought)
+}
+
+func (r *RuleSet) stoch(k, d, k1, d1 float64) Signal {
+	if r = r.orDefault(); r.Stoch != nil {
+		return r.Stoch(k, d, k1, d1)
+	}
+	return stochRule(k, d, k1, d1, r.StochOversold, r.StochOverbought)
+}
+
+func (r *RuleSet) cci(cci, cci1 float64) Signal {
+	if r = r.orDefault(); r.CCI != nil {
+		return r.CCI(cci, cci1)
+	}
+	return cciRule(cci, cci1, r.CCIOversold, r.CCIOverbought)
+}
+
+func (r *RuleSet) adx(adx, plusDI, minusDI, plusDI1, minusDI1 float64) Signal {
+	if r = r.orDefault(); r.ADX != nil {
+		return r.ADX(adx, plusDI, minusDI, plusDI1, minusDI1)
+	}
+	return adxRule(adx, plusDI, minusDI, plusDI1, minusDI1, r.ADXTrend)
+}
+
+func (r *RuleSet) ao(ao, ao1, ao2 float64) Signal {
+	if r = r.orDefault(); r.AO != nil {
+		return r.AO(ao, ao1, ao2)
+	}
+	return tvAO(ao, ao1, ao2)
+}
+
+func (r *RuleSet) mom(mom, mom1 float64) Signal {
+	if r = r.orDefault(); r.Mom != nil {
+		return r.Mom(mom, mom1)
+	}
+	return tvMom(mom, mom1)
+}
+
+func (r *RuleSet) macd(macd, signal float64) Signal {
+	if r = r.orDefault(); r.MACD != nil {
+		return r.MACD(macd, signal)
+	}
+	return tvMACD(macd, signal)
+}
+
+func (r *RuleSet) ma(ma, close float64) Signal {
+	if r = r.orDefault(); r.MA != nil {
+		return r.MA(ma, close)
+	}
+	return tvMA(ma, close)
+}
+
+func (r *RuleSet) simple(rating float64) Signal {
+	if r = r.orDefault(); r.Simple != nil {
+		return r.Simple(rating)
+	}
+	return tvSimple(rating)
+}
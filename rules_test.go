@@ -0,0 +1,181 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestRuleSet_Defaults(t *testing.T) {
+	var nilRules *RuleSet
+	defaults := DefaultRules()
+	for _, rules := range []*RuleSet{nilRules, &defaults} {
+		for v := -1.2; v <= 1.2; v += 0.05 {
+			if got, want := rules.recommend(v), tvComputeRecommend(v); got != want {
+				t.Fatalf("recommend(%v) = %v, want %v", v, got, want)
+			}
+		}
+		if rules.rsi(25, 20) != SignalBuy || rules.stoch(15, 10, 5, 10) != SignalBuy || rules.cci(-150, -200) != SignalBuy {
+			t.Fatal("default thresholds were not applied")
+		}
+		if rules.adx(25, 30, 20, 20, 30) != SignalBuy || rules.adx(15, 30, 20, 20, 30) != SignalNeutral {
+			t.Fatal("default ADX trend threshold was not applied")
+		}
+	}
+
+	// DefaultRules returns a copy.
+	defaults.RSIOversold = 50
+	if DefaultRules().RSIOversold != 30 || nilRules.rsi(35, 30) != SignalNeutral {
+		t.Fatal("modifying a copy changed the default rules")
+	}
+}
+
+func TestRuleSet_ZeroThresholds(t *testing.T) {
+	rules := DefaultRules()
+	rules.Sell = 0
+	rules.ADXTrend = 0
+
+	if got := rules.recommend(-0.05); got != SignalSell {
+		t.Errorf("recommend(-0.05) with Sell 0 = %v, want SELL", got)
+	}
+	if got := rules.adx(5, 30, 20, 20, 30); got != SignalBuy {
+		t.Errorf("adx with ADXTrend 0 = %v, want BUY", got)
+	}
+}
+
+func TestRuleSet_Thresholds(t *testing.T) {
+	rules := DefaultRules()
+	rules.RSIOversold, rules.RSIOverbought = 40, 60
+	rules.StochOversold = 30
+	rules.CCIOverbought = 50
+	rules.ADXTrend = 30
+	rules.StrongSell, rules.StrongBuy = -0.8, 0.8
+
+	tests := []struct {
+		name string
+		got  Signal
+		want Signal
+	}{
+		{"RSI oversold", rules.rsi(35, 30), SignalBuy},
+		{"RSI overbought", rules.rsi(65, 70), SignalSell},
+		{"Stoch oversold", rules.stoch(25, 22, 20, 21), SignalBuy},
+		{"Stoch overbought default", rules.stoch(85, 90, 95, 90), SignalSell},
+		{"CCI overbought", rules.cci(75, 80), SignalSell},
+		{"CCI oversold default", rules.cci(-75, -80), SignalNeutral},
+		{"ADX below trend", rules.adx(25, 30, 20, 20, 30), SignalNeutral},
+		{"ADX above trend", rules.adx(35, 30, 20, 20, 30), SignalBuy},
+		{"recommend buy", rules.recommend(0.7), SignalBuy},
+		{"recommend strong buy", rules.recommend(0.9), SignalStrongBuy},
+		{"recommend sell", rules.recommend(-0.7), SignalSell},
+		{"recommend neutral default", rules.recommend(0.05), SignalNeutral},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestRuleSet_Funcs(t *testing.T) {
+	sell := func() Signal { return SignalSell }
+	rules := &RuleSet{
+		RSI:       func(rsi, rsi1 float64) Signal { return sell() },
+		Stoch:     func(k, d, k1, d1 float64) Signal { return sell() },
+		CCI:       func(cci, cci1 float64) Signal { return sell() },
+		ADX:       func(adx, plusDI, minusDI, plusDI1, minusDI1 float64) Signal { return sell() },
+		AO:        func(ao, ao1, ao2 float64) Signal { return sell() },
+		Mom:       func(mom, mom1 float64) Signal { return sell() },
+		MACD:      func(macd, signal float64) Signal { return sell() },
+		MA:        func(ma, close float64) Signal { return sell() },
+		Simple:    func(rating float64) Signal { return sell() },
+		Recommend: func(score float64) Signal { return SignalStrongSell },
+	}
+
+	got := []Signal{
+		rules.rsi(25, 20), rules.stoch(15, 10, 5, 10), rules.cci(-150, -200),
+		rules.adx(25, 30, 20, 20, 30), rules.ao(1, -1, 0), rules.mom(2, 1),
+		rules.macd(2, 1), rules.ma(1, 2), rules.simple(1),
+	}
+	for i, s := range got {
+		if s != SignalSell {
+			t.Errorf("rule %d = %v, want SELL", i, s)
+		}
+	}
+	if rules.recommend(1) != SignalStrongSell {
+		t.Error("Recommend func was not used")
+	}
+}
+
+func TestClient_GetRules(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"RSI": 35, "RSI[1]": 30, "Recommend.All": 0.3}`)
+	}))
+	defer server.Close()
+
+	client := Client{HTTPClient: server.Client(), BaseURL: server.URL, Cache: &Cache{}}
+	ta := &TradingView{}
+	if err := client.Get(ta, "BINANCE:BTCUSDT", ""); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if ta.Recommend.Oscillators.RSI != SignalNeutral || ta.Recommend.Global.Summary != SignalBuy {
+		t.Fatalf("unexpected default signals: %+v", ta.Recommend)
+	}
+
+	rules := DefaultRules()
+	rules.RSIOversold, rules.Buy = 40, 0.4
+	client.Rules = &rules
+	if err := client.Get(ta, "BINANCE:BTCUSDT", ""); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if ta.Recommend.Oscillators.RSI != SignalBuy || ta.Recommend.Global.Summary != SignalNeutral {
+		t.Fatalf("Rules were not applied: %+v", ta.Recommend)
+	}
+	if stats := client.Cache.Stats(); stats.Misses != 2 {
+		t.Fatalf("expected results with different rules to be cached apart, got %+v", stats)
+	}
+}
+
+func TestTradingView_Recompute(t *testing.T) {
+	ta := &TradingView{}
+	ta.populate(map[string]float64{
+		"RSI":           35,
+		"RSI[1]":        30,
+		"Rec.UO":        1,
+		"Recommend.All": 0.3,
+		"close":         100,
+	}, "", nil)
+
+	rules := DefaultRules()
+	rules.RSIOversold = 40
+	rules.Simple = func(float64) Signal { return SignalSell }
+	ta.Recompute(&rules)
+	if ta.Recommend.Oscillators.RSI != SignalBuy || ta.Recommend.Oscillators.UO != SignalSell {
+		t.Fatalf("Recompute did not apply the rules: %+v", ta.Recommend.Oscillators)
+	}
+	if ta.Value.Oscillators.RSI != 35 || ta.Value.Global.Summary != 0.3 {
+		t.Fatalf("Recompute changed values: %+v", ta.Value)
+	}
+
+	// Edited values are used, and setting a missing value makes it present.
+	ta.Value.Oscillators.RSI = 50
+	ta.Value.MovingAverages.EMA10 = 90
+	ta.Recompute(nil)
+	if ta.Recommend.Oscillators.RSI != SignalNeutral || ta.Recommend.Oscillators.UO != SignalBuy {
+		t.Fatalf("Recompute(nil) did not use DefaultRules: %+v", ta.Recommend.Oscillators)
+	}
+	if ta.Recommend.MovingAverages.EMA10 != SignalBuy || slices.Contains(ta.Missing, "EMA10") {
+		t.Fatalf("expected EMA10 to be rated once set, missing %v", ta.Missing)
+	}
+	if !slices.Contains(ta.Missing, "EMA20") {
+		t.Fatalf("expected unset values to stay missing, missing %v", ta.Missing)
+	}
+
+	var nilTA *TradingView
+	nilTA.Recompute(nil)
+}
@@ -0,0 +1,79 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import "time"
+
+// Schedule decides when a Watcher polls next.
+type Schedule interface {
+	// Next returns the time of the next poll after a poll that finished at
+	// now.
+	Next(now time.Time) time.Time
+}
+
+// Every returns a Schedule that polls d after the previous poll finished.
+// A d of zero or less means DefaultWatchInterval.
+func Every(d time.Duration) Schedule {
+	if d <= 0 {
+		d = DefaultWatchInterval
+	}
+	return every(d)
+}
+
+type every time.Duration
+
+func (e every) Next(now time.Time) time.Time {
+	return now.Add(time.Duration(e))
+}
+
+// BarClose is a Schedule that polls shortly after each bar of Interval
+// closes, so every poll sees a newly completed bar.
+//
+// Bars are aligned in UTC: intraday bars to multiples of their length since
+// midnight, daily bars to midnight, weekly bars to Monday midnight and
+// monthly bars to midnight on the first of the month. Offset shifts every
+// boundary, for markets whose sessions do not start at midnight UTC; for
+// example, an Offset of 13h30m aligns hourly bars to a 09:30 New York open
+// during daylight saving time.
+type BarClose struct {
+	// Interval is the bar interval. Aliases accepted by ParseInterval are
+	// allowed; the zero Interval and unknown values mean daily bars.
+	Interval Interval
+	// Delay is how long after the close to poll, giving TradingView time to
+	// publish the completed bar.
+	Delay time.Duration
+	// Offset shifts bar boundaries from their UTC alignment.
+	Offset time.Duration
+}
+
+// Next returns Delay after the first bar close that, delayed, is after now.
+func (b BarClose) Next(now time.Time) time.Time {
+	shift := b.Offset + b.Delay
+	t := now.UTC().Add(-shift)
+
+	var next time.Time
+	switch interval := b.interval(); interval {
+	case Interval1Week:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		monday := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+		next = monday.AddDate(0, 0, 7)
+	case Interval1Month:
+		next = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		d := interval.Duration()
+		next = t.Truncate(d).Add(d)
+	}
+	return next.Add(shift).In(now.Location())
+}
+
+// interval returns the bar interval, resolving aliases and defaulting to
+// daily bars.
+func (b BarClose) interval() Interval {
+	interval, err := ParseInterval(string(b.Interval))
+	if err != nil {
+		return Interval1Day
+	}
+	return interval
+}
@@ -0,0 +1,107 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEvery(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	if got := Every(time.Minute).Next(now); !got.Equal(now.Add(time.Minute)) {
+		t.Fatalf("Every(1m).Next() = %v", got)
+	}
+	if got := Every(0).Next(now); !got.Equal(now.Add(DefaultWatchInterval)) {
+		t.Fatalf("Every(0).Next() = %v", got)
+	}
+}
+
+func TestBarClose_Next(t *testing.T) {
+	// 2026-01-07 is a Wednesday.
+	at := func(day, hour, minute, second int) time.Time {
+		return time.Date(2026, 1, day, hour, minute, second, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		schedule BarClose
+		now      time.Time
+		want     time.Time
+	}{
+		{name: "1m", schedule: BarClose{Interval: Interval1Min}, now: at(7, 10, 3, 20), want: at(7, 10, 4, 0)},
+		{name: "1m on boundary", schedule: BarClose{Interval: Interval1Min}, now: at(7, 10, 4, 0), want: at(7, 10, 5, 0)},
+		{name: "15m", schedule: BarClose{Interval: Interval15Min}, now: at(7, 10, 44, 59), want: at(7, 10, 45, 0)},
+		{name: "1h alias", schedule: BarClose{Interval: "1h"}, now: at(7, 10, 3, 0), want: at(7, 11, 0, 0)},
+		{name: "4h", schedule: BarClose{Interval: Interval4Hour}, now: at(7, 10, 3, 0), want: at(7, 12, 0, 0)},
+		{name: "4h late", schedule: BarClose{Interval: Interval4Hour}, now: at(7, 22, 0, 0), want: at(8, 0, 0, 0)},
+		{name: "1d", schedule: BarClose{Interval: Interval1Day}, now: at(7, 10, 3, 0), want: at(8, 0, 0, 0)},
+		{name: "zero is daily", schedule: BarClose{}, now: at(7, 10, 3, 0), want: at(8, 0, 0, 0)},
+		{name: "1w", schedule: BarClose{Interval: Interval1Week}, now: at(7, 10, 3, 0), want: at(12, 0, 0, 0)},
+		{name: "1w on monday", schedule: BarClose{Interval: Interval1Week}, now: at(12, 0, 0, 0), want: at(19, 0, 0, 0)},
+		{name: "1w on sunday", schedule: BarClose{Interval: Interval1Week}, now: at(11, 23, 0, 0), want: at(12, 0, 0, 0)},
+		{name: "1mo", schedule: BarClose{Interval: Interval1Month}, now: at(7, 10, 3, 0), want: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "1mo december", schedule: BarClose{Interval: Interval1Month}, now: time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC), want: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "delay", schedule: BarClose{Interval: Interval1Hour, Delay: 5 * time.Second}, now: at(7, 10, 3, 0), want: at(7, 11, 0, 5)},
+		{name: "within delay", schedule: BarClose{Interval: Interval1Hour, Delay: 5 * time.Second}, now: at(7, 11, 0, 2), want: at(7, 11, 0, 5)},
+		{name: "after delay", schedule: BarClose{Interval: Interval1Hour, Delay: 5 * time.Second}, now: at(7, 11, 0, 5), want: at(7, 12, 0, 5)},
+		{name: "offset", schedule: BarClose{Interval: Interval1Hour, Offset: 30 * time.Minute}, now: at(7, 14, 0, 0), want: at(7, 14, 30, 0)},
+		{name: "daily offset", schedule: BarClose{Interval: Interval1Day, Offset: 21 * time.Hour}, now: at(7, 22, 0, 0), want: at(8, 21, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Next(tt.now); !got.Equal(tt.want) {
+				t.Fatalf("Next(%v) = %v, want %v", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBarClose_NextLocation(t *testing.T) {
+	loc := time.FixedZone("EST", -5*60*60)
+	now := time.Date(2026, 1, 7, 9, 10, 0, 0, loc)
+
+	got := BarClose{Interval: Interval1Hour}.Next(now)
+	if got.Location() != loc || got.Hour() != 10 || got.Minute() != 0 {
+		t.Fatalf("Next() = %v, want 10:00 in %v", got, loc)
+	}
+}
+
+func TestWatcher_RunSchedule(t *testing.T) {
+	server := sequenceServer(t, map[string]map[string]any{
+		"BINANCE:BTCUSDT": {"close|240": 1},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clock := &pollClock{fakeClock: newFakeClock(), polls: 3, cancel: cancel}
+	w := &Watcher{
+		Client:    &Client{HTTPClient: server.Client(), ScanBaseURL: server.URL},
+		Symbols:   []string{"BINANCE:BTCUSDT"},
+		Intervals: []Interval{Interval4Hour},
+		Every:     time.Second,
+		Schedule:  BarClose{Interval: Interval4Hour, Delay: 10 * time.Second},
+		Clock:     clock,
+	}
+
+	var times []time.Time
+	_ = w.Run(ctx, func(e Event) { times = append(times, e.Time) })
+
+	// The fake clock starts at 15:04:05 UTC.
+	want := []time.Time{
+		time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2026, 1, 2, 16, 0, 10, 0, time.UTC),
+		time.Date(2026, 1, 2, 20, 0, 10, 0, time.UTC),
+	}
+	if len(times) != len(want) {
+		t.Fatalf("expected %d polls, got %v", len(want), times)
+	}
+	for i := range want {
+		if !times[i].Equal(want[i]) {
+			t.Errorf("poll %d at %v, want %v", i, times[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DefaultScreenerLimit is the number of rows a Screener returns when Range
+// is not called.
+const DefaultScreenerLimit = 50
+
+// ErrInvalidMarket reports that a Screener market is empty or contains
+// characters other than lowercase letters, digits and underscores.
+var ErrInvalidMarket = errors.New("tradingview: invalid market")
+
+// Filter operations understood by the scan endpoint.
+const (
+	OpGreater = "greater"
+	OpLess    = "less"
+	OpInRange = "in_range"
+	OpEqual   = "equal"
+	OpMatch   = "match"
+	OpCrosses = "crosses"
+)
+
+// SortOrder is the order of Screener results.
+type SortOrder string
+
+// Sort orders for Screener.Sort.
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// Screener finds the symbols of a market that match a set of filters,
+// using the market scan endpoint. Create one with Client.Screener and chain
+// its methods to build the query:
+//
+//	res, err := client.Screener("crypto").
+//		Interval(tradingview.Interval1Hour).
+//		Equal("exchange", "BINANCE").
+//		Match("name", "USDT").
+//		Greater("Recommend.All|60", 0.5).
+//		Sort("volume", tradingview.SortDesc).
+//		Do(ctx)
+//
+// Field names in filters, sorts and columns are scanner columns as given,
+// including any interval suffix such as "|60". A value may also be the
+// name of another column, as in Greater("close", "EMA50").
+//
+// A Screener is not safe for concurrent use while it is being built.
+type Screener struct {
+	client   *Client
+	market   string
+	interval Interval
+	filters  []scanFilter
+	sort     *scanSort
+	from, to int
+	columns  []string
+}
+
+// ScreenerResult is the result of a Screener query.
+type ScreenerResult struct {
+	// TotalCount is the number of symbols matching the filters, of which
+	// Rows holds the requested range.
+	TotalCount int
+	// Rows holds the matching symbols in result order.
+	Rows []ScreenerRow
+}
+
+// ScreenerRow is a symbol returned by a Screener.
+type ScreenerRow struct {
+	// Symbol is the symbol in EXCHANGE:SYMBOL form.
+	Symbol string
+	// TA holds the recommendations and values of Symbol at the Screener
+	// interval. The values of columns added with Columns are in its Raw and
+	// Strings maps under the column name.
+	TA *TradingView
+}
+
+// scanFilter is a filter of a market scan request.
+type scanFilter struct {
+	Left      string `json:"left"`
+	Operation string `json:"operation"`
+	Right     any    `json:"right"`
+}
+
+// scanSort is the sort order of a market scan request.
+type scanSort struct {
+	SortBy    string    `json:"sortBy"`
+	SortOrder SortOrder `json:"sortOrder"`
+}
+
+// Screener returns a Screener for market, such as "crypto", "america" or
+// "forex".
+func (c *Client) Screener(market string) *Screener {
+	return &Screener{client: c, market: market, to: DefaultScreenerLimit}
+}
+
+// Interval sets the interval of the recommendations and values returned for
+// each row. It does not change the field names of filters.
+func (s *Screener) Interval(interval Interval) *Screener {
+	s.interval = interval
+	return s
+}
+
+// Filter adds a filter comparing field with value using operation, for
+// operations without a dedicated method.
+func (s *Screener) Filter(field, operation string, value any) *Screener {
+	s.filters = append(s.filters, scanFilter{Left: field, Operation: operation, Right: value})
+	return s
+}
+
+// Greater keeps symbols whose field is greater than value.
+func (s *Screener) Greater(field string, value any) *Screener {
+	return s.Filter(field, OpGreater, value)
+}
+
+// Less keeps symbols whose field is less than value.
+func (s *Screener) Less(field string, value any) *Screener {
+	return s.Filter(field, OpLess, value)
+}
+
+// InRange keeps symbols whose field is between low and high inclusive.
+func (s *Screener) InRange(field string, low, high any) *Screener {
+	return s.Filter(field, OpInRange, []any{low, high})
+}
+
+// Equal keeps symbols whose field equals value.
+func (s *Screener) Equal(field string, value any) *Screener {
+	return s.Filter(field, OpEqual, value)
+}
+
+// Match keeps symbols whose field contains text, ignoring case.
+func (s *Screener) Match(field, text string) *Screener {
+	return s.Filter(field, OpMatch, text)
+}
+
+// Crosses keeps symbols whose field crossed other, a value or the name of
+// another column, on the last bar.
+func (s *Screener) Crosses(field string, other any) *Screener {
+	return s.Filter(field, OpCrosses, other)
+}
+
+// Sort orders the results by field.
+func (s *Screener) Sort(field string, order SortOrder) *Screener {
+	s.sort = &scanSort{SortBy: field, SortOrder: order}
+	return s
+}
+
+// Range selects the rows from index from up to, but not including, index
+// to. It defaults to the first DefaultScreenerLimit rows.
+func (s *Screener) Range(from, to int) *Screener {
+	s.from, s.to = from, to
+	return s
+}
+
+// Columns requests additional columns for each row.
+func (s *Screener) Columns(columns ...string) *Screener {
+	s.columns = append(s.columns, columns...)
+	return s
+}
+
+// Do sends the query and returns the matching rows. It uses the client's
+// Retry, RateLimiter, Rules and Extra settings; Strict and Cache do not
+// apply.
+func (s *Screener) Do(ctx context.Context) (*ScreenerResult, error) {
+	if !validMarket(s.market) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidMarket, s.market)
+	}
+	if s.from < 0 || s.to < s.from {
+		return nil, fmt.Errorf("tradingview: invalid screener range [%d, %d)", s.from, s.to)
+	}
+	dataInterval, err := s.client.intervalSuffix(s.interval)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := append(s.client.columns(dataInterval), s.columns...)
+	res, err := s.client.scan(ctx, s.market, s.interval, scanRequest{
+		Filter:  s.filters,
+		Options: &scanOptions{Lang: "en"},
+		Markets: []string{s.market},
+		Symbols: scanSymbols{Query: &scanQuery{Types: []string{}}, Tickers: []string{}},
+		Columns: columns,
+		Sort:    s.sort,
+		Range:   []int{s.from, s.to},
+	})
+	if err != nil {
+		return nil, err
+	}
+	fetchedAt := time.Now()
+
+	extra := s.client.extra()
+	extra.Static = slices.Concat(extra.Static, s.columns)
+	result := &ScreenerResult{TotalCount: res.TotalCount, Rows: make([]ScreenerRow, 0, len(res.Data))}
+	for _, row := range res.Data {
+		responseMap, strs, err := decodeRow(columns, row.Values)
+		if err != nil {
+			return nil, err
+		}
+		ta := &TradingView{}
+		ta.populate(responseMap, dataInterval, s.client.rules())
+		ta.populateExtra(extra, responseMap, strs, dataInterval)
+		ta.setSource(row.Symbol, s.interval, fetchedAt)
+		result.Rows = append(result.Rows, ScreenerRow{Symbol: row.Symbol, TA: ta})
+	}
+	return result, nil
+}
+
+// validMarket reports whether market can be used in a scan URL.
+func validMarket(market string) bool {
+	if market == "" {
+		return false
+	}
+	for _, r := range market {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestScreener_Do(t *testing.T) {
+	var path string
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var req scanRequest
+		_ = json.Unmarshal(data, &req)
+		row := func(symbol string, all, close float64) map[string]any {
+			values := make([]any, len(req.Columns))
+			for i, column := range req.Columns {
+				switch column {
+				case "Recommend.All|60":
+					values[i] = all
+				case "close|60":
+					values[i] = close
+				case "description":
+					values[i] = symbol + " pair"
+				}
+			}
+			return map[string]any{"s": symbol, "d": values}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"totalCount": 42,
+			"data": []any{
+				row("BINANCE:BTCUSDT", 0.6, 70000),
+				row("BINANCE:ETHUSDT", 0.55, 3500),
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+	}
+
+	res, err := client.Screener("crypto").
+		Interval(Interval1Hour).
+		Equal("exchange", "BINANCE").
+		Match("name", "USDT").
+		Greater("Recommend.All|60", 0.5).
+		Less("RSI|60", 80).
+		InRange("change|60", -5, 5).
+		Crosses("MACD.macd|60", "MACD.signal|60").
+		Sort("volume", SortDesc).
+		Range(10, 20).
+		Columns("description").
+		Do(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if path != "/crypto/scan" {
+		t.Fatalf("unexpected path %q", path)
+	}
+
+	wantFilters := `[` +
+		`{"left":"exchange","operation":"equal","right":"BINANCE"},` +
+		`{"left":"name","operation":"match","right":"USDT"},` +
+		`{"left":"Recommend.All|60","operation":"greater","right":0.5},` +
+		`{"left":"RSI|60","operation":"less","right":80},` +
+		`{"left":"change|60","operation":"in_range","right":[-5,5]},` +
+		`{"left":"MACD.macd|60","operation":"crosses","right":"MACD.signal|60"}]`
+	assertJSON(t, "filter", body["filter"], wantFilters)
+	assertJSON(t, "sort", body["sort"], `{"sortBy":"volume","sortOrder":"desc"}`)
+	assertJSON(t, "range", body["range"], `[10,20]`)
+	assertJSON(t, "markets", body["markets"], `["crypto"]`)
+	assertJSON(t, "symbols", body["symbols"], `{"query":{"types":[]},"tickers":[]}`)
+
+	columns, _ := body["columns"].([]any)
+	if len(columns) != len(fieldsForInterval(""))+1 || columns[len(columns)-1] != "description" {
+		t.Fatalf("unexpected columns: %v", columns)
+	}
+
+	if res.TotalCount != 42 || len(res.Rows) != 2 {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	btc := res.Rows[0]
+	if btc.Symbol != "BINANCE:BTCUSDT" || btc.TA.Recommend.Global.Summary != SignalStrongBuy || btc.TA.Value.Prices.Close != 70000 {
+		t.Fatalf("unexpected first row: %s %+v", btc.Symbol, btc.TA)
+	}
+	if btc.TA.Strings["description"] != "BINANCE:BTCUSDT pair" {
+		t.Fatalf("unexpected extra column: %v", btc.TA.Strings)
+	}
+	if res.Rows[1].Symbol != "BINANCE:ETHUSDT" {
+		t.Fatalf("unexpected row order: %+v", res.Rows)
+	}
+}
+
+func TestScreener_DoDefaults(t *testing.T) {
+	var req map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_, _ = w.Write([]byte(`{"totalCount": 0, "data": []}`))
+	}))
+	defer server.Close()
+
+	client := Client{HTTPClient: server.Client(), ScanBaseURL: server.URL}
+	res, err := client.Screener("america").Do(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.TotalCount != 0 || res.Rows == nil || len(res.Rows) != 0 {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	assertJSON(t, "range", req["range"], `[0,50]`)
+	for _, field := range []string{"filter", "sort"} {
+		if _, ok := req[field]; ok {
+			t.Fatalf("expected %s to be omitted, got %v", field, req[field])
+		}
+	}
+}
+
+func TestScreener_DoRetries(t *testing.T) {
+	server, calls := flakyServer(t, http.StatusServiceUnavailable)
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+		Retry:       &RetryPolicy{Clock: newFakeClock()},
+	}
+	if _, err := client.Screener("crypto").Do(context.Background()); err != nil {
+		t.Fatalf("expected the retry to succeed, got %v", err)
+	}
+	if *calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestScreener_DoInvalid(t *testing.T) {
+	var client Client
+	ctx := context.Background()
+
+	for _, market := range []string{"", "crypto/../x", "Crypto"} {
+		if _, err := client.Screener(market).Do(ctx); !errors.Is(err, ErrInvalidMarket) {
+			t.Fatalf("market %q: expected ErrInvalidMarket, got %v", market, err)
+		}
+	}
+	if _, err := client.Screener("crypto").Range(5, 1).Do(ctx); err == nil {
+		t.Fatal("expected error for invalid range")
+	}
+	if _, err := client.Screener("crypto").Interval("7m").Do(ctx); !errors.Is(err, ErrInvalidInterval) {
+		t.Fatalf("expected ErrInvalidInterval, got %v", err)
+	}
+}
+
+func Test_validMarket(t *testing.T) {
+	var valid []string
+	for _, market := range []string{"crypto", "america", "forex", "cfd", "coin_2", "", "a b", "x/scan"} {
+		if validMarket(market) {
+			valid = append(valid, market)
+		}
+	}
+	if want := []string{"crypto", "america", "forex", "cfd", "coin_2"}; !slices.Equal(valid, want) {
+		t.Fatalf("valid markets = %v, want %v", valid, want)
+	}
+}
+
+// assertJSON fails t if got does not encode to want.
+func assertJSON(t *testing.T, name string, got any, want string) {
+	t.Helper()
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if string(data) != want {
+		t.Fatalf("%s = %s, want %s", name, data, want)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const defaultSearchURL = "https://symbol-search.tradingview.com/symbol_search/v3/"
+
+// SymbolInfo describes an instrument found by SearchSymbols.
+type SymbolInfo struct {
+	// Symbol is the instrument, ready to be passed to GetSymbol.
+	Symbol Symbol
+	// Description is the instrument name, such as "Bitcoin / TetherUS".
+	Description string
+	// Type is the instrument type, such as "crypto", "stock", "futures",
+	// "forex", "index" or "fund".
+	Type string
+	// Currency is the quote currency, such as "USDT".
+	Currency string
+	// Provider is the data provider, such as "binance".
+	Provider string
+}
+
+// SearchOptions narrows the results of SearchSymbols.
+type SearchOptions struct {
+	// Exchange, if set, restricts results to an exchange, such as
+	// "BINANCE".
+	Exchange string
+	// Type, if set, restricts results to an instrument type, such as
+	// "crypto", "stock", "futures" or "forex".
+	Type string
+	// Limit, if positive, caps the number of results.
+	Limit int
+}
+
+// searchResponse is the body of a symbol search response.
+type searchResponse struct {
+	Symbols []searchSymbol `json:"symbols"`
+}
+
+type searchSymbol struct {
+	Symbol       string `json:"symbol"`
+	Description  string `json:"description"`
+	Type         string `json:"type"`
+	Exchange     string `json:"exchange"`
+	Prefix       string `json:"prefix"`
+	SourceID     string `json:"source_id"`
+	CurrencyCode string `json:"currency_code"`
+	ProviderID   string `json:"provider_id"`
+}
+
+// searchTypes maps SearchOptions.Type values to the search_type parameter
+// where they differ.
+var searchTypes = map[string]string{
+	"stock": "stocks",
+	"fund":  "funds",
+}
+
+// highlight removes the markup the search endpoint adds around matches.
+var highlight = strings.NewReplacer("<em>", "", "</em>", "")
+
+// SearchSymbols looks up instruments matching query, such as "BTCUSDT" or
+// "apple", with TradingView's symbol search. Opts may be nil.
+//
+// Results are in the order TradingView ranks them. A query without matches
+// returns an empty slice and no error.
+func (c *Client) SearchSymbols(ctx context.Context, query string, opts *SearchOptions) ([]SymbolInfo, error) {
+	return c.search(ctx, query, opts, requestInfo{})
+}
+
+// ResolveSymbol confirms that symbol, in the form "EXCHANGE:SYMBOL", exists
+// and returns its description. Symbol is normalized as by ParseSymbol. If
+// TradingView does not know it, the error matches ErrSymbolNotFound.
+func (c *Client) ResolveSymbol(ctx context.Context, symbol string) (*SymbolInfo, error) {
+	parsed, err := ParseSymbol(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := c.search(ctx, parsed.Ticker, &SearchOptions{Exchange: parsed.Exchange}, requestInfo{symbol: parsed.String()})
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range results {
+		if info.Symbol == parsed {
+			return &info, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrSymbolNotFound, parsed)
+}
+
+func (c *Client) search(ctx context.Context, query string, opts *SearchOptions, info requestInfo) ([]SymbolInfo, error) {
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+	searchType := opts.Type
+	if t, ok := searchTypes[searchType]; ok {
+		searchType = t
+	}
+
+	params := url.Values{}
+	params.Set("text", query)
+	params.Set("exchange", strings.ToUpper(opts.Exchange))
+	params.Set("search_type", searchType)
+	params.Set("hl", "0")
+	params.Set("lang", "en")
+	params.Set("domain", "production")
+
+	searchURL, err := c.searchURL(params)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	// The search endpoint rejects requests from other origins.
+	req.Header.Set("Origin", "https://www.tradingview.com")
+
+	jsonData, err := c.do(ctx, req, info)
+	if err != nil {
+		return nil, err
+	}
+
+	var res searchResponse
+	if err := json.Unmarshal(jsonData, &res); err != nil {
+		return nil, newDecodeError(jsonData, err)
+	}
+
+	results := make([]SymbolInfo, 0, len(res.Symbols))
+	for _, s := range res.Symbols {
+		if opts.Limit > 0 && len(results) == opts.Limit {
+			break
+		}
+		results = append(results, s.info())
+	}
+	return results, nil
+}
+
+// info converts a search result to a SymbolInfo.
+func (s searchSymbol) info() SymbolInfo {
+	exchange := s.Prefix
+	if exchange == "" {
+		exchange = s.SourceID
+	}
+	if exchange == "" {
+		exchange = s.Exchange
+	}
+	typ := s.Type
+	if typ == "spot" {
+		typ = "crypto"
+	}
+
+	return SymbolInfo{
+		Symbol: Symbol{
+			Exchange: strings.ToUpper(highlight.Replace(exchange)),
+			Ticker:   strings.ToUpper(highlight.Replace(s.Symbol)),
+		},
+		Description: highlight.Replace(s.Description),
+		Type:        typ,
+		Currency:    s.CurrencyCode,
+		Provider:    s.ProviderID,
+	}
+}
+
+// searchURL returns the search endpoint with params added to its query.
+func (c *Client) searchURL(params url.Values) (string, error) {
+	base := defaultSearchURL
+	if c != nil && c.SearchURL != "" {
+		base = c.SearchURL
+	}
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("parse search URL: %w", err)
+	}
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
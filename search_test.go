@@ -0,0 +1,140 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const searchBody = `{"symbols_remaining": 0, "symbols": [
+	{"symbol": "<em>BTCUSDT</em>", "description": "Bitcoin / TetherUS", "type": "spot",
+	 "exchange": "Binance", "source_id": "BINANCE", "currency_code": "USDT", "provider_id": "binance"},
+	{"symbol": "BTCUSDT.P", "description": "Bitcoin / TetherUS Perpetual", "type": "swap",
+	 "exchange": "Binance", "prefix": "BINANCE", "currency_code": "USDT", "provider_id": "binance"},
+	{"symbol": "BTCUSD", "description": "Bitcoin / U.S. Dollar", "type": "spot",
+	 "exchange": "Coinbase", "currency_code": "USD", "provider_id": "coinbase"}
+]}`
+
+func searchServer(t *testing.T, query *url.Values) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*query = r.URL.Query()
+		if r.Header.Get("Origin") == "" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(searchBody))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_SearchSymbols(t *testing.T) {
+	var query url.Values
+	server := searchServer(t, &query)
+	client := Client{HTTPClient: server.Client(), SearchURL: server.URL}
+
+	results, err := client.SearchSymbols(context.Background(), "btcusd", &SearchOptions{Exchange: "binance", Type: "stock"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if query.Get("text") != "btcusd" || query.Get("exchange") != "BINANCE" || query.Get("search_type") != "stocks" {
+		t.Fatalf("unexpected query: %v", query)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+
+	want := SymbolInfo{
+		Symbol:      Symbol{Exchange: "BINANCE", Ticker: "BTCUSDT"},
+		Description: "Bitcoin / TetherUS",
+		Type:        "crypto",
+		Currency:    "USDT",
+		Provider:    "binance",
+	}
+	if results[0] != want {
+		t.Fatalf("got %+v, want %+v", results[0], want)
+	}
+	if results[1].Symbol.String() != "BINANCE:BTCUSDT.P" || results[1].Type != "swap" {
+		t.Fatalf("unexpected second result: %+v", results[1])
+	}
+	if results[2].Symbol.Exchange != "COINBASE" {
+		t.Fatalf("expected exchange name as fallback, got %+v", results[2])
+	}
+
+	limited, err := client.SearchSymbols(context.Background(), "btc", &SearchOptions{Limit: 1})
+	if err != nil || len(limited) != 1 {
+		t.Fatalf("expected 1 result, got %v, %v", limited, err)
+	}
+}
+
+func TestClient_SearchSymbolsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "slow down", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := Client{HTTPClient: server.Client(), SearchURL: server.URL}
+	if _, err := client.SearchSymbols(context.Background(), "btc", nil); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+}
+
+func TestClient_ResolveSymbol(t *testing.T) {
+	var query url.Values
+	server := searchServer(t, &query)
+	client := Client{HTTPClient: server.Client(), SearchURL: server.URL}
+	ctx := context.Background()
+
+	info, err := client.ResolveSymbol(ctx, " binance:btcusdt.p ")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if info.Description != "Bitcoin / TetherUS Perpetual" {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+	if query.Get("text") != "BTCUSDT.P" || query.Get("exchange") != "BINANCE" {
+		t.Fatalf("unexpected query: %v", query)
+	}
+
+	if _, err := client.ResolveSymbol(ctx, "BINANCE:BTCEUR"); !errors.Is(err, ErrSymbolNotFound) {
+		t.Fatalf("expected ErrSymbolNotFound, got %v", err)
+	}
+	if _, err := client.ResolveSymbol(ctx, "BTCUSDT"); !errors.Is(err, ErrInvalidSymbol) {
+		t.Fatalf("expected ErrInvalidSymbol, got %v", err)
+	}
+}
+
+func TestClient_searchURL(t *testing.T) {
+	params := url.Values{"text": {"btc"}, "hl": {"0"}}
+	tests := []struct {
+		base string
+		want string
+	}{
+		{"", defaultSearchURL + "?hl=0&text=btc"},
+		{"http://localhost/search", "http://localhost/search?hl=0&text=btc"},
+		{"http://localhost/search?", "http://localhost/search?hl=0&text=btc"},
+		{"http://localhost/search?key=1", "http://localhost/search?hl=0&key=1&text=btc"},
+		{"http://localhost/search?key=1&", "http://localhost/search?hl=0&key=1&text=btc"},
+		{"http://localhost/search?text=old", "http://localhost/search?hl=0&text=btc"},
+	}
+	for _, tt := range tests {
+		client := Client{SearchURL: tt.base}
+		got, err := client.searchURL(params)
+		if err != nil || got != tt.want {
+			t.Errorf("searchURL(%q) = %q, %v, want %q", tt.base, got, err, tt.want)
+		}
+	}
+
+	client := Client{SearchURL: "http://[::1"}
+	if _, err := client.searchURL(params); err == nil {
+		t.Error("expected an error for an invalid URL")
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Signal is a normalized recommendation signal.
+//
+// Signals are ordered from SignalStrongSell to SignalStrongBuy, so they can be
+// compared numerically. A Signal is encoded as its String form in JSON and
+// other text formats.
+type Signal int
+
+// Normalized recommendation signals returned in TradingView.Recommend.
+const (
+	// SignalStrongBuy indicates a strong buy recommendation.
+	SignalStrongBuy Signal = 2
+	// SignalBuy indicates a buy recommendation.
+	SignalBuy Signal = 1
+	// SignalNeutral indicates a neutral recommendation.
+	SignalNeutral Signal = 0
+	// SignalSell indicates a sell recommendation.
+	SignalSell Signal = -1
+	// SignalStrongSell indicates a strong sell recommendation.
+	SignalStrongSell Signal = -2
+)
+
+// ErrInvalidSignal reports that a string does not name a Signal.
+var ErrInvalidSignal = errors.New("tradingview: invalid signal")
+
+// String returns the TradingView name of s, such as "STRONG_BUY" or
+// "NEUTRAL". Values outside the known range are formatted as "Signal(n)".
+func (s Signal) String() string {
+	switch s {
+	case SignalStrongBuy:
+		return "STRONG_BUY"
+	case SignalBuy:
+		return "BUY"
+	case SignalNeutral:
+		return "NEUTRAL"
+	case SignalSell:
+		return "SELL"
+	case SignalStrongSell:
+		return "STRONG_SELL"
+	default:
+		return fmt.Sprintf("Signal(%d)", int(s))
+	}
+}
+
+// IsBullish reports whether s is SignalBuy or SignalStrongBuy.
+func (s Signal) IsBullish() bool {
+	return s > SignalNeutral
+}
+
+// IsBearish reports whether s is SignalSell or SignalStrongSell.
+func (s Signal) IsBearish() bool {
+	return s < SignalNeutral
+}
+
+// IsNeutral reports whether s is SignalNeutral.
+func (s Signal) IsNeutral() bool {
+	return s == SignalNeutral
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Signal) MarshalText() ([]byte, error) {
+	if s < SignalStrongSell || s > SignalStrongBuy {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSignal, int(s))
+	}
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the forms
+// understood by ParseSignal.
+func (s *Signal) UnmarshalText(text []byte) error {
+	signal, err := ParseSignal(string(text))
+	if err != nil {
+		return err
+	}
+	*s = signal
+	return nil
+}
+
+// ParseSignal parses a signal name such as "STRONG_BUY" or "sell".
+//
+// Matching ignores case, surrounding spaces, and the separator between
+// "strong" and the direction, so "Strong Buy" and "strong-buy" are accepted.
+// The numeric forms "-2" through "2" are accepted as well.
+func ParseSignal(text string) (Signal, error) {
+	name := strings.ToUpper(strings.TrimSpace(text))
+	if n, err := strconv.Atoi(name); err == nil {
+		name = Signal(n).String()
+	}
+	name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+
+	switch name {
+	case "STRONG_BUY":
+		return SignalStrongBuy, nil
+	case "BUY":
+		return SignalBuy, nil
+	case "NEUTRAL":
+		return SignalNeutral, nil
+	case "SELL":
+		return SignalSell, nil
+	case "STRONG_SELL":
+		return SignalStrongSell, nil
+	default:
+		return SignalNeutral, fmt.Errorf("%w: %q", ErrInvalidSignal, text)
+	}
+}
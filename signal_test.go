@@ -0,0 +1,134 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSignal_String(t *testing.T) {
+	tests := []struct {
+		signal Signal
+		want   string
+	}{
+		{SignalStrongBuy, "STRONG_BUY"},
+		{SignalBuy, "BUY"},
+		{SignalNeutral, "NEUTRAL"},
+		{SignalSell, "SELL"},
+		{SignalStrongSell, "STRONG_SELL"},
+		{Signal(5), "Signal(5)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.signal.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignal_Values(t *testing.T) {
+	// The numeric values are part of the public API.
+	if SignalStrongBuy != 2 || SignalBuy != 1 || SignalNeutral != 0 || SignalSell != -1 || SignalStrongSell != -2 {
+		t.Fatal("signal values changed")
+	}
+}
+
+func TestSignal_Direction(t *testing.T) {
+	tests := []struct {
+		signal                      Signal
+		bullish, bearish, isNeutral bool
+	}{
+		{SignalStrongBuy, true, false, false},
+		{SignalBuy, true, false, false},
+		{SignalNeutral, false, false, true},
+		{SignalSell, false, true, false},
+		{SignalStrongSell, false, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.signal.String(), func(t *testing.T) {
+			if got := tt.signal.IsBullish(); got != tt.bullish {
+				t.Errorf("IsBullish() = %v, want %v", got, tt.bullish)
+			}
+			if got := tt.signal.IsBearish(); got != tt.bearish {
+				t.Errorf("IsBearish() = %v, want %v", got, tt.bearish)
+			}
+			if got := tt.signal.IsNeutral(); got != tt.isNeutral {
+				t.Errorf("IsNeutral() = %v, want %v", got, tt.isNeutral)
+			}
+		})
+	}
+}
+
+func TestParseSignal(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    Signal
+		wantErr bool
+	}{
+		{"STRONG_BUY", SignalStrongBuy, false},
+		{"strong buy", SignalStrongBuy, false},
+		{" Strong-Sell ", SignalStrongSell, false},
+		{"buy", SignalBuy, false},
+		{"NEUTRAL", SignalNeutral, false},
+		{"sell", SignalSell, false},
+		{"2", SignalStrongBuy, false},
+		{"-1", SignalSell, false},
+		{"0", SignalNeutral, false},
+		{"3", SignalNeutral, true},
+		{"hold", SignalNeutral, true},
+		{"", SignalNeutral, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseSignal(tt.text)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidSignal) {
+					t.Fatalf("expected ErrInvalidSignal, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseSignal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignal_JSON(t *testing.T) {
+	in := GlobalRecommendations{
+		Summary:     SignalStrongBuy,
+		Oscillators: SignalNeutral,
+		MA:          SignalSell,
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if want := `{"summary":"STRONG_BUY","oscillators":"NEUTRAL","ma":"SELL"}`; string(data) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var out GlobalRecommendations
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out != in {
+		t.Fatalf("round trip = %+v, want %+v", out, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Summary":"MAYBE"}`), &out); !errors.Is(err, ErrInvalidSignal) {
+		t.Fatalf("expected ErrInvalidSignal, got %v", err)
+	}
+	if _, err := json.Marshal(Signal(7)); !errors.Is(err, ErrInvalidSignal) {
+		t.Fatalf("expected ErrInvalidSignal for out of range signal, got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Symbol identifies a TradingView instrument as an exchange and a ticker,
+// for example BINANCE:BTCUSDT, CME_MINI:ES1! or NASDAQ:BRK.B.
+type Symbol struct {
+	// Exchange is the exchange or data provider prefix, such as "BINANCE".
+	Exchange string
+	// Ticker is the instrument name on Exchange, such as "BTCUSDT".
+	Ticker string
+}
+
+// SymbolError describes why a symbol is invalid. It matches
+// ErrInvalidSymbol with errors.Is.
+type SymbolError struct {
+	// Symbol is the symbol as given.
+	Symbol string
+	// Part is the invalid part: "exchange", "ticker", or "" when the
+	// symbol does not have the form EXCHANGE:SYMBOL.
+	Part string
+	// Reason explains what is wrong with Part.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *SymbolError) Error() string {
+	if e.Part == "" {
+		return fmt.Sprintf("tradingview: invalid symbol %q: %s", e.Symbol, e.Reason)
+	}
+	return fmt.Sprintf("tradingview: invalid symbol %q: %s %s", e.Symbol, e.Part, e.Reason)
+}
+
+// Unwrap returns ErrInvalidSymbol.
+func (e *SymbolError) Unwrap() error {
+	return ErrInvalidSymbol
+}
+
+// ParseSymbol parses a symbol of the form "EXCHANGE:TICKER".
+//
+// Surrounding spaces are removed and both parts are upper-cased, so
+// " binance:btcusdt " becomes BINANCE:BTCUSDT. The exchange may contain
+// letters, digits and underscores. The ticker may also contain '.', '!',
+// '-' and '&', as in NASDAQ:BRK.B and CME_MINI:ES1!. Invalid symbols are
+// reported as a *SymbolError.
+func ParseSymbol(text string) (Symbol, error) {
+	exchange, ticker, ok := strings.Cut(strings.TrimSpace(text), ":")
+	if !ok {
+		return Symbol{}, &SymbolError{Symbol: text, Reason: "missing ':' between exchange and ticker"}
+	}
+
+	sym := Symbol{
+		Exchange: strings.ToUpper(strings.TrimSpace(exchange)),
+		Ticker:   strings.ToUpper(strings.TrimSpace(ticker)),
+	}
+	if err := sym.validate(text); err != nil {
+		return Symbol{}, err
+	}
+	return sym, nil
+}
+
+// String returns s in the form "EXCHANGE:TICKER".
+func (s Symbol) String() string {
+	return s.Exchange + ":" + s.Ticker
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Symbol) MarshalText() ([]byte, error) {
+	if err := s.validate(s.String()); err != nil {
+		return nil, err
+	}
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseSymbol.
+func (s *Symbol) UnmarshalText(text []byte) error {
+	sym, err := ParseSymbol(string(text))
+	if err != nil {
+		return err
+	}
+	*s = sym
+	return nil
+}
+
+// GetSymbol is like GetContext but takes a Symbol.
+func (c *Client) GetSymbol(ctx context.Context, ta *TradingView, symbol Symbol, interval Interval) error {
+	return c.GetContext(ctx, ta, symbol.String(), interval)
+}
+
+// validate checks the parts of s. Text is the symbol as given, for errors.
+func (s Symbol) validate(text string) error {
+	switch {
+	case s.Exchange == "":
+		return &SymbolError{Symbol: text, Part: "exchange", Reason: "is empty"}
+	case s.Ticker == "":
+		return &SymbolError{Symbol: text, Part: "ticker", Reason: "is empty"}
+	}
+	if r, ok := firstInvalid(s.Exchange, isExchangeRune); ok {
+		return &SymbolError{Symbol: text, Part: "exchange", Reason: fmt.Sprintf("contains invalid character %q", r)}
+	}
+	if r, ok := firstInvalid(s.Ticker, isTickerRune); ok {
+		return &SymbolError{Symbol: text, Part: "ticker", Reason: fmt.Sprintf("contains invalid character %q", r)}
+	}
+	return nil
+}
+
+// normalizeSymbol parses symbol and returns its canonical form.
+func normalizeSymbol(symbol string) (string, error) {
+	sym, err := ParseSymbol(symbol)
+	if err != nil {
+		return "", err
+	}
+	return sym.String(), nil
+}
+
+// firstInvalid returns the first rune in s for which valid is false.
+func firstInvalid(s string, valid func(rune) bool) (rune, bool) {
+	for _, r := range s {
+		if !valid(r) {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+func isExchangeRune(r rune) bool {
+	return (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_'
+}
+
+func isTickerRune(r rune) bool {
+	return isExchangeRune(r) || strings.ContainsRune(".!-&", r)
+}
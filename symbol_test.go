@@ -0,0 +1,154 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseSymbol(t *testing.T) {
+	tests := []struct {
+		text     string
+		want     Symbol
+		wantPart string
+		wantErr  bool
+	}{
+		{text: "BINANCE:BTCUSDT", want: Symbol{"BINANCE", "BTCUSDT"}},
+		{text: " binance:btcusdt ", want: Symbol{"BINANCE", "BTCUSDT"}},
+		{text: "CME_MINI:ES1!", want: Symbol{"CME_MINI", "ES1!"}},
+		{text: "NASDAQ:BRK.B", want: Symbol{"NASDAQ", "BRK.B"}},
+		{text: "BINANCE:BTCUSDT.P", want: Symbol{"BINANCE", "BTCUSDT.P"}},
+		{text: "BTCUSDT", wantErr: true},
+		{text: "", wantErr: true},
+		{text: "BINANCE:", wantPart: "ticker", wantErr: true},
+		{text: ":BTC", wantPart: "exchange", wantErr: true},
+		{text: "BINANCE:BTC:USDT", wantPart: "ticker", wantErr: true},
+		{text: "BIN ANCE:BTC", wantPart: "exchange", wantErr: true},
+		{text: "BINANCE:BTC$", wantPart: "ticker", wantErr: true},
+		{text: "BINANCE.US:BTC", wantPart: "exchange", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseSymbol(tt.text)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidSymbol) {
+					t.Fatalf("expected ErrInvalidSymbol, got %v", err)
+				}
+				var symErr *SymbolError
+				if !errors.As(err, &symErr) {
+					t.Fatalf("expected *SymbolError, got %T", err)
+				}
+				if symErr.Part != tt.wantPart || symErr.Symbol != tt.text {
+					t.Fatalf("unexpected error %+v", symErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseSymbol(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSymbolError_Error(t *testing.T) {
+	_, err := ParseSymbol(":BTC")
+	if want := `tradingview: invalid symbol ":BTC": exchange is empty`; err.Error() != want {
+		t.Fatalf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	_, err = ParseSymbol("BTC")
+	if want := `tradingview: invalid symbol "BTC": missing ':' between exchange and ticker`; err.Error() != want {
+		t.Fatalf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestSymbol_Text(t *testing.T) {
+	var cfg struct {
+		Symbol Symbol
+	}
+	if err := json.Unmarshal([]byte(`{"Symbol":"nasdaq:brk.b"}`), &cfg); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if cfg.Symbol != (Symbol{"NASDAQ", "BRK.B"}) {
+		t.Fatalf("unexpected symbol: %+v", cfg.Symbol)
+	}
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if want := `{"Symbol":"NASDAQ:BRK.B"}`; string(data) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	if _, err := json.Marshal(Symbol{Exchange: "BINANCE"}); !errors.Is(err, ErrInvalidSymbol) {
+		t.Fatalf("expected ErrInvalidSymbol, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"Symbol":"BINANCE:"}`), &cfg); !errors.Is(err, ErrInvalidSymbol) {
+		t.Fatalf("expected ErrInvalidSymbol, got %v", err)
+	}
+}
+
+func TestClient_GetNormalizesSymbol(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.Query().Get("symbol"))
+		_, _ = w.Write([]byte(`{"close|60": 1}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	ta := &TradingView{}
+	if err := client.Get(ta, " binance:btcusdt ", Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := client.GetSymbol(context.Background(), ta, Symbol{Exchange: "cme_mini", Ticker: "es1!"}, Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 2 || got[0] != "BINANCE:BTCUSDT" || got[1] != "CME_MINI:ES1!" {
+		t.Fatalf("unexpected symbols sent: %v", got)
+	}
+
+	if err := client.GetSymbol(context.Background(), ta, Symbol{Ticker: "BTC"}, Interval1Hour); !errors.Is(err, ErrInvalidSymbol) {
+		t.Fatalf("expected ErrInvalidSymbol, got %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatal("invalid symbol should not reach the server")
+	}
+}
+
+func TestClient_GetManyNormalizesSymbols(t *testing.T) {
+	server := httptest.NewServer(scanHandler(t, map[string]map[string]any{
+		"BINANCE:BTCUSDT": {"close": 1},
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient:  server.Client(),
+		ScanBaseURL: server.URL,
+	}
+
+	results, err := client.GetMany(context.Background(), []string{" binance:btcusdt", "BINANCE:BTCUSDT", "BINANCE:"}, Interval1Day)
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 1 || !errors.Is(batchErr.Errors["BINANCE:"], ErrInvalidSymbol) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || results["BINANCE:BTCUSDT"] == nil {
+		t.Fatalf("unexpected results: %v", results)
+	}
+}
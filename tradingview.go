@@ -5,6 +5,7 @@
 package tradingview
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,7 +16,10 @@ import (
 	"time"
 )
 
-const defaultScannerURL = "https://scanner.tradingview.com/symbol"
+const (
+	defaultScannerURL  = "https://scanner.tradingview.com/symbol"
+	defaultScanBaseURL = "https://scanner.tradingview.com"
+)
 
 var (
 	defaultHTTPClient = &http.Client{
@@ -26,330 +30,413 @@ var (
 	ErrNilTradingView = errors.New("tradingview: nil TradingView receiver")
 	// ErrInvalidSymbol reports that a symbol does not have the form EXCHANGE:SYMBOL.
 	ErrInvalidSymbol = errors.New("tradingview: symbol must be in EXCHANGE:SYMBOL format")
+	// ErrSymbolNotFound reports that TradingView returned no data for a symbol.
+	ErrSymbolNotFound = errors.New("tradingview: symbol not found")
+	// ErrMissingFields reports that TradingView returned no value for some
+	// requested fields. It is only returned by clients in strict mode.
+	ErrMissingFields = errors.New("tradingview: missing fields")
 	// DefaultClient is used by (*TradingView).Get.
 	DefaultClient = Client{
-		HTTPClient: defaultHTTPClient,
-		BaseURL:    defaultScannerURL,
+		HTTPClient:  defaultHTTPClient,
+		BaseURL:     defaultScannerURL,
+		ScanBaseURL: defaultScanBaseURL,
+		SearchURL:   defaultSearchURL,
 	}
 )
 
-// Supported interval values for Get.
-const (
-	// Interval1Min requests 1-minute data.
-	Interval1Min = "1"
-	// Interval5Min requests 5-minute data.
-	Interval5Min = "5"
-	// Interval15Min requests 15-minute data.
-	Interval15Min = "15"
-	// Interval30Min requests 30-minute data.
-	Interval30Min = "30"
-	// Interval1Hour requests 1-hour data.
-	Interval1Hour = "60"
-	// Interval2Hour requests 2-hour data.
-	Interval2Hour = "120"
-	// Interval4Hour requests 4-hour data.
-	Interval4Hour = "240"
-	// Interval1Day requests 1-day data.
-	Interval1Day = "1D"
-	// Interval1Week requests 1-week data.
-	Interval1Week = "1W"
-	// Interval1Month requests 1-month data.
-	Interval1Month = "1M"
-)
-
-// Normalized recommendation signals returned in TradingView.Recommend.
-const (
-	// SignalStrongBuy indicates a strong buy recommendation.
-	SignalStrongBuy = 2
-	// SignalBuy indicates a buy recommendation.
-	SignalBuy = 1
-	// SignalNeutral indicates a neutral recommendation.
-	SignalNeutral = 0
-	// SignalSell indicates a sell recommendation.
-	SignalSell = -1
-	// SignalStrongSell indicates a strong sell recommendation.
-	SignalStrongSell = -2
-)
-
 // TradingView holds normalized recommendations and raw values returned by
 // TradingView's scanner endpoint.
 //
+// It encodes to JSON with snake_case field names, such as
+// "recommend.moving_averages.sma200" and "value.pivots.classic.r1", and
+// signals encoded by name, such as "STRONG_BUY". These names are stable and
+// decode back into an equal TradingView.
+//
 // Its zero value is ready to use.
 type TradingView struct {
+	// Symbol is the normalized symbol the result was fetched for. It is
+	// empty for results not fetched by a Client, such as FromFields.
+	Symbol string `json:"symbol,omitempty"`
+	// Interval is the interval the result was fetched at, as an Interval
+	// constant such as Interval1Hour. Daily results report Interval1Day.
+	Interval Interval `json:"interval,omitempty"`
+	// FetchedAt is when the response was received. Results served from a
+	// Cache report the time of the original request.
+	FetchedAt time.Time `json:"fetched_at,omitzero"`
 	// Recommend contains normalized recommendation signals.
-	Recommend Recommendations
+	Recommend Recommendations `json:"recommend"`
 	// Value contains raw numeric indicator and price values.
-	Value Values
+	Value Values `json:"value"`
+	// Missing lists the fields, without interval suffix, for which
+	// TradingView returned no value or null. Recommendations that depend on
+	// a missing field are neutral, and values read from it are zero.
+	Missing []string `json:"missing,omitempty"`
+	// Raw holds the numeric value of every field TradingView returned,
+	// including the extra fields requested with Client.Extra, keyed by
+	// field name without interval suffix.
+	Raw map[string]float64 `json:"raw,omitempty"`
+	// Strings holds the string values of extra fields, such as
+	// "description", keyed by field name without interval suffix.
+	Strings map[string]string `json:"strings,omitempty"`
+}
+
+// IsSet reports whether TradingView returned a value for field. Field is a
+// scanner field name without interval suffix, for example "EMA200" or
+// "Pivot.M.Classic.R1".
+func (ta *TradingView) IsSet(field string) bool {
+	if ta == nil {
+		return false
+	}
+	if _, ok := ta.Raw[field]; ok {
+		return true
+	}
+	_, ok := ta.Strings[field]
+	return ok
+}
+
+// MissingFieldsError lists the fields missing from a response when the
+// client is in strict mode. It matches ErrMissingFields with errors.Is.
+type MissingFieldsError struct {
+	// Symbol is the requested symbol.
+	Symbol string
+	// Interval is the requested interval.
+	Interval Interval
+	// Fields lists the missing fields without interval suffix.
+	Fields []string
+}
+
+// Error implements the error interface.
+func (e *MissingFieldsError) Error() string {
+	return fmt.Sprintf("tradingview: missing fields for %s at interval %q: %s", e.Symbol, e.Interval, strings.Join(e.Fields, ", "))
+}
+
+// Is reports whether target is ErrMissingFields.
+func (e *MissingFieldsError) Is(target error) bool {
+	return target == ErrMissingFields
 }
 
 // Recommendations groups normalized BUY, SELL, and NEUTRAL signals.
 type Recommendations struct {
 	// Global contains the combined recommendation groups.
-	Global GlobalRecommendations
+	Global GlobalRecommendations `json:"global"`
 	// Oscillators contains oscillator recommendations.
-	Oscillators OscillatorRecommendations
+	Oscillators OscillatorRecommendations `json:"oscillators"`
 	// MovingAverages contains moving-average recommendations.
-	MovingAverages MovingAverageRecommendations
+	MovingAverages MovingAverageRecommendations `json:"moving_averages"`
 }
 
 // GlobalRecommendations stores the combined recommendation groups.
 type GlobalRecommendations struct {
-	Summary     int // Summary recommendation
-	Oscillators int // Oscillators recommendation
-	MA          int // Moving Averages recommendation
+	Summary     Signal `json:"summary"`     // Summary recommendation
+	Oscillators Signal `json:"oscillators"` // Oscillators recommendation
+	MA          Signal `json:"ma"`          // Moving Averages recommendation
 }
 
 // OscillatorRecommendations stores normalized signals for oscillator indicators.
 type OscillatorRecommendations struct {
-	RSI      int // Relative Strength Index (14)
-	StochK   int // Stochastic %K (14, 3, 3)
-	CCI      int // Commodity Channel Index (20)
-	ADX      int // Average Directional Index (14)
-	AO       int // Awesome Oscillator
-	Mom      int // Momentum (10)
-	MACD     int // MACD Level (12, 26)
-	StochRSI int // Stochastic RSI Fast (3, 3, 14, 14)
-	WR       int // Williams Percent Range (14)
-	BBP      int // Bull Bear Power
-	UO       int // Ultimate Oscillator (7, 14, 28)
+	RSI      Signal `json:"rsi"`       // Relative Strength Index (14)
+	StochK   Signal `json:"stoch_k"`   // Stochastic %K (14, 3, 3)
+	CCI      Signal `json:"cci"`       // Commodity Channel Index (20)
+	ADX      Signal `json:"adx"`       // Average Directional Index (14)
+	AO       Signal `json:"ao"`        // Awesome Oscillator
+	Mom      Signal `json:"mom"`       // Momentum (10)
+	MACD     Signal `json:"macd"`      // MACD Level (12, 26)
+	StochRSI Signal `json:"stoch_rsi"` // Stochastic RSI Fast (3, 3, 14, 14)
+	WR       Signal `json:"wr"`        // Williams Percent Range (14)
+	BBP      Signal `json:"bbp"`       // Bull Bear Power
+	UO       Signal `json:"uo"`        // Ultimate Oscillator (7, 14, 28)
 }
 
 // MovingAverageRecommendations stores normalized signals for moving-average indicators.
 type MovingAverageRecommendations struct {
-	EMA10    int // Exponential Moving Average (EMA10)
-	SMA10    int // Simple Moving Average (SMA10)
-	EMA20    int // Exponential Moving Average (EMA20)
-	SMA20    int // Simple Moving Average (SMA20)
-	EMA30    int // Exponential Moving Average (EMA30)
-	SMA30    int // Simple Moving Average (SMA30)
-	EMA50    int // Exponential Moving Average (EMA50)
-	SMA50    int // Simple Moving Average (SMA50)
-	EMA100   int // Exponential Moving Average (EMA100)
-	SMA100   int // Simple Moving Average (SMA100)
-	EMA200   int // Exponential Moving Average (EMA200)
-	SMA200   int // Simple Moving Average (SMA200)
-	Ichimoku int // Ichimoku Base Line (9, 26, 52, 26)
-	VWMA     int // Volume Weighted Moving Average (20)
-	HullMA   int // Hull Moving Average (HullMA9)
+	EMA10    Signal `json:"ema10"`    // Exponential Moving Average (EMA10)
+	SMA10    Signal `json:"sma10"`    // Simple Moving Average (SMA10)
+	EMA20    Signal `json:"ema20"`    // Exponential Moving Average (EMA20)
+	SMA20    Signal `json:"sma20"`    // Simple Moving Average (SMA20)
+	EMA30    Signal `json:"ema30"`    // Exponential Moving Average (EMA30)
+	SMA30    Signal `json:"sma30"`    // Simple Moving Average (SMA30)
+	EMA50    Signal `json:"ema50"`    // Exponential Moving Average (EMA50)
+	SMA50    Signal `json:"sma50"`    // Simple Moving Average (SMA50)
+	EMA100   Signal `json:"ema100"`   // Exponential Moving Average (EMA100)
+	SMA100   Signal `json:"sma100"`   // Simple Moving Average (SMA100)
+	EMA200   Signal `json:"ema200"`   // Exponential Moving Average (EMA200)
+	SMA200   Signal `json:"sma200"`   // Simple Moving Average (SMA200)
+	Ichimoku Signal `json:"ichimoku"` // Ichimoku Base Line (9, 26, 52, 26)
+	VWMA     Signal `json:"vwma"`     // Volume Weighted Moving Average (20)
+	HullMA   Signal `json:"hull_ma"`  // Hull Moving Average (HullMA9)
 }
 
 // Values groups raw numeric values returned by TradingView.
 type Values struct {
 	// Global contains the raw combined recommendation values.
-	Global GlobalValues
+	Global GlobalValues `json:"global"`
 	// Oscillators contains raw oscillator values.
-	Oscillators OscillatorValues
+	Oscillators OscillatorValues `json:"oscillators"`
 	// MovingAverages contains raw moving-average values.
-	MovingAverages MovingAverageValues
+	MovingAverages MovingAverageValues `json:"moving_averages"`
 	// Pivots contains pivot levels.
-	Pivots PivotValues
+	Pivots PivotValues `json:"pivots"`
 	// Prices contains raw price values.
-	Prices PriceValues
+	Prices PriceValues `json:"prices"`
 }
 
 // GlobalValues stores the raw combined recommendation values.
 type GlobalValues struct {
-	Summary     float64 // Summary recommendation
-	Oscillators float64 // Oscillators recommendation
-	MA          float64 // Moving Averages recommendation
+	Summary     float64 `json:"summary"`     // Summary recommendation
+	Oscillators float64 `json:"oscillators"` // Oscillators recommendation
+	MA          float64 `json:"ma"`          // Moving Averages recommendation
 }
 
 // OscillatorValues stores raw oscillator values.
 type OscillatorValues struct {
-	RSI      float64    // Relative Strength Index (14)
-	StochK   float64    // Stochastic %K (14, 3, 3)
-	CCI      float64    // Commodity Channel Index (20)
-	ADX      ADXValues  // Average Directional Index (14)
-	AO       AOValues   // Awesome Oscillator
-	Mom      float64    // Momentum (10)
-	MACD     MACDValues // MACD Level (12, 26)
-	StochRSI float64    // Stochastic RSI Fast (3, 3, 14, 14)
-	WR       float64    // Williams Percent Range (14)
-	BBP      float64    // Bull Bear Power
-	UO       float64    // Ultimate Oscillator (7, 14, 28)
+	RSI      float64    `json:"rsi"`       // Relative Strength Index (14)
+	StochK   float64    `json:"stoch_k"`   // Stochastic %K (14, 3, 3)
+	CCI      float64    `json:"cci"`       // Commodity Channel Index (20)
+	ADX      ADXValues  `json:"adx"`       // Average Directional Index (14)
+	AO       AOValues   `json:"ao"`        // Awesome Oscillator
+	Mom      float64    `json:"mom"`       // Momentum (10)
+	MACD     MACDValues `json:"macd"`      // MACD Level (12, 26)
+	StochRSI float64    `json:"stoch_rsi"` // Stochastic RSI Fast (3, 3, 14, 14)
+	WR       float64    `json:"wr"`        // Williams Percent Range (14)
+	BBP      float64    `json:"bbp"`       // Bull Bear Power
+	UO       float64    `json:"uo"`        // Ultimate Oscillator (7, 14, 28)
 }
 
 // ADXValues stores Average Directional Index values.
 type ADXValues struct {
-	Value    float64 // ADX Value
-	PlusDI   float64 // ADX+DI
-	MinusDI  float64 // ADX-DI
-	PlusDI1  float64 // ADX+DI[1]
-	MinusDI1 float64 // ADX-DI[1]
+	Value    float64 `json:"value"`     // ADX Value
+	PlusDI   float64 `json:"plus_di"`   // ADX+DI
+	MinusDI  float64 `json:"minus_di"`  // ADX-DI
+	PlusDI1  float64 `json:"plus_di1"`  // ADX+DI[1]
+	MinusDI1 float64 `json:"minus_di1"` // ADX-DI[1]
 }
 
 // AOValues stores Awesome Oscillator values.
 type AOValues struct {
-	Value float64 // AO current value
-	Prev1 float64 // AO[1]
-	Prev2 float64 // AO[2]
+	Value float64 `json:"value"` // AO current value
+	Prev1 float64 `json:"prev1"` // AO[1]
+	Prev2 float64 `json:"prev2"` // AO[2]
 }
 
 // MACDValues stores MACD line values.
 type MACDValues struct {
-	Macd   float64 // MACD line
-	Signal float64 // Signal line
+	Macd   float64 `json:"macd"`   // MACD line
+	Signal float64 `json:"signal"` // Signal line
 }
 
 // MovingAverageValues stores raw moving-average values.
 type MovingAverageValues struct {
-	EMA10    float64 // Exponential Moving Average (EMA10)
-	SMA10    float64 // Simple Moving Average (SMA10)
-	EMA20    float64 // Exponential Moving Average (EMA20)
-	SMA20    float64 // Simple Moving Average (SMA20)
-	EMA30    float64 // Exponential Moving Average (EMA30)
-	SMA30    float64 // Simple Moving Average (SMA30)
-	EMA50    float64 // Exponential Moving Average (EMA50)
-	SMA50    float64 // Simple Moving Average (SMA50)
-	EMA100   float64 // Exponential Moving Average (EMA100)
-	SMA100   float64 // Simple Moving Average (SMA100)
-	EMA200   float64 // Exponential Moving Average (EMA200)
-	SMA200   float64 // Simple Moving Average (SMA200)
-	Ichimoku float64 // Ichimoku Base Line (9, 26, 52, 26)
-	VWMA     float64 // Volume Weighted Moving Average (20)
-	HullMA   float64 // Hull Moving Average (HullMA9)
+	EMA10    float64 `json:"ema10"`    // Exponential Moving Average (EMA10)
+	SMA10    float64 `json:"sma10"`    // Simple Moving Average (SMA10)
+	EMA20    float64 `json:"ema20"`    // Exponential Moving Average (EMA20)
+	SMA20    float64 `json:"sma20"`    // Simple Moving Average (SMA20)
+	EMA30    float64 `json:"ema30"`    // Exponential Moving Average (EMA30)
+	SMA30    float64 `json:"sma30"`    // Simple Moving Average (SMA30)
+	EMA50    float64 `json:"ema50"`    // Exponential Moving Average (EMA50)
+	SMA50    float64 `json:"sma50"`    // Simple Moving Average (SMA50)
+	EMA100   float64 `json:"ema100"`   // Exponential Moving Average (EMA100)
+	SMA100   float64 `json:"sma100"`   // Simple Moving Average (SMA100)
+	EMA200   float64 `json:"ema200"`   // Exponential Moving Average (EMA200)
+	SMA200   float64 `json:"sma200"`   // Simple Moving Average (SMA200)
+	Ichimoku float64 `json:"ichimoku"` // Ichimoku Base Line (9, 26, 52, 26)
+	VWMA     float64 `json:"vwma"`     // Volume Weighted Moving Average (20)
+	HullMA   float64 `json:"hull_ma"`  // Hull Moving Average (HullMA9)
 }
 
 // PivotValues stores pivot levels for the supported pivot systems.
 type PivotValues struct {
-	Classic   ClassicPivotLevels
-	Fibonacci FibonacciPivotLevels
-	Camarilla CamarillaPivotLevels
-	Woodie    WoodiePivotLevels
-	Demark    DemarkPivotLevels
+	Classic   ClassicPivotLevels   `json:"classic"`
+	Fibonacci FibonacciPivotLevels `json:"fibonacci"`
+	Camarilla CamarillaPivotLevels `json:"camarilla"`
+	Woodie    WoodiePivotLevels    `json:"woodie"`
+	Demark    DemarkPivotLevels    `json:"demark"`
 }
 
 // ClassicPivotLevels stores Classic pivot levels.
 type ClassicPivotLevels struct {
-	Middle float64 // Classic Pivot Middle (Pivot.M.Classic.Middle)
-	R1     float64 // Resistance 1 (Pivot.M.Classic.R1)
-	R2     float64 // Resistance 2 (Pivot.M.Classic.R2)
-	R3     float64 // Resistance 3 (Pivot.M.Classic.R3)
-	S1     float64 // Support 1 (Pivot.M.Classic.S1)
-	S2     float64 // Support 2 (Pivot.M.Classic.S2)
-	S3     float64 // Support 3 (Pivot.M.Classic.S3)
+	Middle float64 `json:"middle"` // Classic Pivot Middle (Pivot.M.Classic.Middle)
+	R1     float64 `json:"r1"`     // Resistance 1 (Pivot.M.Classic.R1)
+	R2     float64 `json:"r2"`     // Resistance 2 (Pivot.M.Classic.R2)
+	R3     float64 `json:"r3"`     // Resistance 3 (Pivot.M.Classic.R3)
+	S1     float64 `json:"s1"`     // Support 1 (Pivot.M.Classic.S1)
+	S2     float64 `json:"s2"`     // Support 2 (Pivot.M.Classic.S2)
+	S3     float64 `json:"s3"`     // Support 3 (Pivot.M.Classic.S3)
 }
 
 // FibonacciPivotLevels stores Fibonacci pivot levels.
 type FibonacciPivotLevels struct {
-	Middle float64 // Fibonacci Pivot Middle (Pivot.M.Fibonacci.Middle)
-	R1     float64 // Resistance 1 (Pivot.M.Fibonacci.R1)
-	R2     float64 // Resistance 2 (Pivot.M.Fibonacci.R2)
-	R3     float64 // Resistance 3 (Pivot.M.Fibonacci.R3)
-	S1     float64 // Support 1 (Pivot.M.Fibonacci.S1)
-	S2     float64 // Support 2 (Pivot.M.Fibonacci.S2)
-	S3     float64 // Support 3 (Pivot.M.Fibonacci.S3)
+	Middle float64 `json:"middle"` // Fibonacci Pivot Middle (Pivot.M.Fibonacci.Middle)
+	R1     float64 `json:"r1"`     // Resistance 1 (Pivot.M.Fibonacci.R1)
+	R2     float64 `json:"r2"`     // Resistance 2 (Pivot.M.Fibonacci.R2)
+	R3     float64 `json:"r3"`     // Resistance 3 (Pivot.M.Fibonacci.R3)
+	S1     float64 `json:"s1"`     // Support 1 (Pivot.M.Fibonacci.S1)
+	S2     float64 `json:"s2"`     // Support 2 (Pivot.M.Fibonacci.S2)
+	S3     float64 `json:"s3"`     // Support 3 (Pivot.M.Fibonacci.S3)
 }
 
 // CamarillaPivotLevels stores Camarilla pivot levels.
 type CamarillaPivotLevels struct {
-	Middle float64 // Camarilla Pivot Middle (Pivot.M.Camarilla.Middle)
-	R1     float64 // Resistance 1 (Pivot.M.Camarilla.R1)
-	R2     float64 // Resistance 2 (Pivot.M.Camarilla.R2)
-	R3     float64 // Resistance 3 (Pivot.M.Camarilla.R3)
-	S1     float64 // Support 1 (Pivot.M.Camarilla.S1)
-	S2     float64 // Support 2 (Pivot.M.Camarilla.S2)
-	S3     float64 // Support 3 (Pivot.M.Camarilla.S3)
+	Middle float64 `json:"middle"` // Camarilla Pivot Middle (Pivot.M.Camarilla.Middle)
+	R1     float64 `json:"r1"`     // Resistance 1 (Pivot.M.Camarilla.R1)
+	R2     float64 `json:"r2"`     // Resistance 2 (Pivot.M.Camarilla.R2)
+	R3     float64 `json:"r3"`     // Resistance 3 (Pivot.M.Camarilla.R3)
+	S1     float64 `json:"s1"`     // Support 1 (Pivot.M.Camarilla.S1)
+	S2     float64 `json:"s2"`     // Support 2 (Pivot.M.Camarilla.S2)
+	S3     float64 `json:"s3"`     // Support 3 (Pivot.M.Camarilla.S3)
 }
 
 // WoodiePivotLevels stores Woodie pivot levels.
 type WoodiePivotLevels struct {
-	Middle float64 // Woodie Pivot Middle (Pivot.M.Woodie.Middle)
-	R1     float64 // Resistance 1 (Pivot.M.Woodie.R1)
-	R2     float64 // Resistance 2 (Pivot.M.Woodie.R2)
-	R3     float64 // Resistance 3 (Pivot.M.Woodie.R3)
-	S1     float64 // Support 1 (Pivot.M.Woodie.S1)
-	S2     float64 // Support 2 (Pivot.M.Woodie.S2)
-	S3     float64 // Support 3 (Pivot.M.Woodie.S3)
+	Middle float64 `json:"middle"` // Woodie Pivot Middle (Pivot.M.Woodie.Middle)
+	R1     float64 `json:"r1"`     // Resistance 1 (Pivot.M.Woodie.R1)
+	R2     float64 `json:"r2"`     // Resistance 2 (Pivot.M.Woodie.R2)
+	R3     float64 `json:"r3"`     // Resistance 3 (Pivot.M.Woodie.R3)
+	S1     float64 `json:"s1"`     // Support 1 (Pivot.M.Woodie.S1)
+	S2     float64 `json:"s2"`     // Support 2 (Pivot.M.Woodie.S2)
+	S3     float64 `json:"s3"`     // Support 3 (Pivot.M.Woodie.S3)
 }
 
 // DemarkPivotLevels stores Demark pivot levels.
 type DemarkPivotLevels struct {
-	Middle float64 // Demark Pivot Middle (Pivot.M.Demark.Middle)
-	R1     float64 // Resistance 1 (Pivot.M.Demark.R1)
-	S1     float64 // Support 1 (Pivot.M.Demark.S1)
+	Middle float64 `json:"middle"` // Demark Pivot Middle (Pivot.M.Demark.Middle)
+	R1     float64 `json:"r1"`     // Resistance 1 (Pivot.M.Demark.R1)
+	S1     float64 `json:"s1"`     // Support 1 (Pivot.M.Demark.S1)
 }
 
 // PriceValues stores the close, high, and low values returned by TradingView.
 type PriceValues struct {
-	Close float64 // Closing price
-	High  float64 // Highest price
-	Low   float64 // Lowest price
+	Close float64 `json:"close"` // Closing price
+	High  float64 `json:"high"`  // Highest price
+	Low   float64 `json:"low"`   // Lowest price
 }
 
 // Client fetches technical-analysis data from the TradingView scanner endpoint.
 //
 // If HTTPClient is nil, Get uses a default client with a 10 second timeout.
 // If BaseURL is empty, Get uses TradingView's public scanner endpoint.
+// If ScanBaseURL is empty, GetMany uses TradingView's public scanner host.
+// If SearchURL is empty, SearchSymbols uses TradingView's public symbol
+// search.
+// If Strict is set, a response missing any requested field is reported as a
+// *MissingFieldsError. If LegacyIntervals is set, unknown intervals are
+// treated as daily data instead of being rejected.
 // The zero value of Client is ready to use.
 type Client struct {
 	// HTTPClient is used to make requests.
 	HTTPClient *http.Client
 	// BaseURL is the scanner endpoint base URL.
 	BaseURL string
+	// ScanBaseURL is the base URL of the market scan endpoints. Requests are
+	// sent to ScanBaseURL + "/" + market + "/scan".
+	ScanBaseURL string
+	// Strict makes Get return an error when TradingView omits a field. The
+	// TradingView result is still populated.
+	Strict bool
+	// LegacyIntervals restores the historical behavior of treating unknown
+	// intervals as daily data.
+	LegacyIntervals bool
+	// Retry controls how failed requests are retried. If nil, requests are
+	// not retried.
+	Retry *RetryPolicy
+	// RateLimiter, if set, is waited on before every request, including
+	// retries. It may be shared between clients.
+	RateLimiter *RateLimiter
+	// Rules decides how values become signals. If nil, DefaultRules() is
+	// used.
+	Rules *RuleSet
+	// Cache, if set, keeps results of Get, GetContext and GetSymbol and
+	// shares identical requests in flight.
+	Cache *Cache
+	// Extra lists scanner fields to request in addition to the ones needed
+	// for recommendations.
+	Extra FieldSet
+	// SearchURL is the symbol search endpoint used by SearchSymbols and
+	// ResolveSymbol.
+	SearchURL string
 }
 
 // Get populates ta with recommendations and raw indicator values for symbol
 // at interval.
 //
 // Symbol must have the form "EXCHANGE:SYMBOL", for example "BINANCE:BTCUSDT".
-// An empty or unknown interval is treated as daily data.
-func (ta *TradingView) Get(symbol, interval string) error {
+// It is normalized as by ParseSymbol before it is sent.
+// An empty interval is treated as daily data. Interval may also be one of
+// the aliases accepted by ParseInterval; other values are rejected with
+// ErrInvalidInterval.
+func (ta *TradingView) Get(symbol string, interval Interval) error {
 	return DefaultClient.Get(ta, symbol, interval)
 }
 
+// GetContext is like Get but uses ctx for the request.
+func (ta *TradingView) GetContext(ctx context.Context, symbol string, interval Interval) error {
+	return DefaultClient.GetContext(ctx, ta, symbol, interval)
+}
+
 // Get populates ta with recommendations and raw indicator values for symbol
 // at interval using c.
 //
 // Symbol must have the form "EXCHANGE:SYMBOL", for example "BINANCE:BTCUSDT".
-// An empty or unknown interval is treated as daily data.
-func (c *Client) Get(ta *TradingView, symbol, interval string) error {
+// It is normalized as by ParseSymbol before it is sent.
+// An empty interval is treated as daily data. Interval may also be one of
+// the aliases accepted by ParseInterval; other values are rejected with
+// ErrInvalidInterval unless c.LegacyIntervals is set.
+func (c *Client) Get(ta *TradingView, symbol string, interval Interval) error {
+	return c.GetContext(context.Background(), ta, symbol, interval)
+}
+
+// GetContext is like Get but uses ctx for the request.
+//
+// If ctx is canceled or its deadline expires before the response is read,
+// the returned error wraps ctx.Err().
+func (c *Client) GetContext(ctx context.Context, ta *TradingView, symbol string, interval Interval) error {
 	if ta == nil {
 		return ErrNilTradingView
 	}
-	if err := validateSymbol(symbol); err != nil {
+	symbol, err := normalizeSymbol(symbol)
+	if err != nil {
+		return err
+	}
+
+	dataInterval, err := c.intervalSuffix(interval)
+	if err != nil {
 		return err
 	}
+	fetch := func() (*TradingView, error) {
+		responseMap, strs, err := c.getResponseMap(ctx, symbol, interval, c.columns(dataInterval))
+		if err != nil {
+			return nil, err
+		}
+		result := &TradingView{}
+		result.populate(responseMap, dataInterval, c.rules())
+		result.populateExtra(c.extra(), responseMap, strs, dataInterval)
+		result.setSource(symbol, interval, time.Now())
+		return result, nil
+	}
 
-	dataInterval := intervalSuffix(interval)
-	responseMap, err := c.getResponseMap(symbol, dataInterval)
+	var result *TradingView
+	if c != nil && c.Cache != nil {
+		result, err = c.Cache.get(ctx, cacheKey{symbol: symbol, interval: dataInterval, rules: c.Rules, extra: c.Extra.key()}, interval, fetch)
+	} else {
+		result, err = fetch()
+	}
 	if err != nil {
 		return err
 	}
 
-	ta.populate(responseMap, dataInterval)
-	return nil
-}
-
-func validateSymbol(symbol string) error {
-	if strings.Count(symbol, ":") != 1 {
-		return ErrInvalidSymbol
-	}
-	return nil
-}
-
-func intervalSuffix(interval string) string {
-	switch interval {
-	case Interval1Min:
-		return "|1"
-	case Interval5Min:
-		return "|5"
-	case Interval15Min:
-		return "|15"
-	case Interval30Min:
-		return "|30"
-	case Interval1Hour:
-		return "|60"
-	case Interval2Hour:
-		return "|120"
-	case Interval4Hour:
-		return "|240"
-	case Interval1Week:
-		return "|1W"
-	case Interval1Month:
-		return "|1M"
-	default:
-		return ""
+	*ta = *result
+	return c.checkMissing(symbol, interval, ta)
+}
+
+// checkMissing returns a *MissingFieldsError if c is strict and ta is
+// missing fields.
+func (c *Client) checkMissing(symbol string, interval Interval, ta *TradingView) error {
+	if c == nil || !c.Strict || len(ta.Missing) == 0 {
+		return nil
+	}
+	return &MissingFieldsError{
+		Symbol:   symbol,
+		Interval: interval,
+		Fields:   append([]string(nil), ta.Missing...),
 	}
 }
 
@@ -441,13 +528,13 @@ func fieldsForInterval(dataInterval string) []string {
 	}
 }
 
-func (c *Client) newRequest(symbol, dataInterval string) (*http.Request, error) {
+func (c *Client) newRequest(ctx context.Context, symbol string, fields []string) (*http.Request, error) {
 	params := url.Values{}
 	params.Add("symbol", symbol)
-	params.Add("fields", strings.Join(fieldsForInterval(dataInterval), ","))
+	params.Add("fields", strings.Join(fields, ","))
 
 	reqURL := c.baseURL() + params.Encode()
-	req, err := http.NewRequest(http.MethodGet, reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
@@ -455,21 +542,93 @@ func (c *Client) newRequest(symbol, dataInterval string) (*http.Request, error)
 	return req, nil
 }
 
-func (c *Client) getResponseMap(symbol, dataInterval string) (map[string]float64, error) {
-	req, err := c.newRequest(symbol, dataInterval)
+// getResponseMap requests fields for symbol and returns the numeric and the
+// string values by column. Interval is reported in errors; it is empty when
+// fields cover several intervals.
+func (c *Client) getResponseMap(ctx context.Context, symbol string, interval Interval, fields []string) (map[string]float64, map[string]string, error) {
+	req, err := c.newRequest(ctx, symbol, fields)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	jsonData, err := c.do(ctx, req, requestInfo{symbol: symbol, interval: interval})
+	if err != nil {
+		return nil, nil, err
+	}
+	return decodeResponse(jsonData)
+}
+
+// requestInfo describes what a request asked for, for error reporting.
+type requestInfo struct {
+	symbol   string
+	interval Interval
+}
+
+// do sends req, retrying according to c.Retry, and returns the body of a
+// successful response. Other responses are reported as an *APIError.
+func (c *Client) do(ctx context.Context, req *http.Request, info requestInfo) ([]byte, error) {
+	if c == nil || c.Retry == nil {
+		return c.send(ctx, req, info)
+	}
+	return c.Retry.run(ctx, func() ([]byte, error) {
+		attempt := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("create request: %w", err)
+			}
+			attempt.Body = body
+		}
+		return c.send(ctx, attempt, info)
+	})
+}
+
+// send sends req once and returns the body of a successful response.
+func (c *Client) send(ctx context.Context, req *http.Request, info requestInfo) ([]byte, error) {
+	if c != nil {
+		if err := c.RateLimiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("wait for rate limiter: %w", err)
+		}
 	}
 
 	res, err := c.httpClient().Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("send request: %w", err)
+		return nil, contextError(ctx, fmt.Errorf("send request: %w", err))
+	}
+
+	jsonData, err := readResponse(res, info, c.clock().Now())
+	if err != nil {
+		return nil, contextError(ctx, err)
+	}
+	return jsonData, nil
+}
+
+// clock returns the Clock of c's RetryPolicy, or else of its RateLimiter,
+// or SystemClock.
+func (c *Client) clock() Clock {
+	switch {
+	case c == nil:
+		return SystemClock
+	case c.Retry != nil && c.Retry.Clock != nil:
+		return c.Retry.Clock
+	case c.RateLimiter != nil:
+		return clockOrSystem(c.RateLimiter.Clock)
+	default:
+		return SystemClock
 	}
+}
 
-	return decodeResponse(res)
+// contextError makes err wrap ctx.Err() when ctx is done, so callers can
+// match context.Canceled and context.DeadlineExceeded with errors.Is.
+func contextError(ctx context.Context, err error) error {
+	ctxErr := ctx.Err()
+	if ctxErr == nil || errors.Is(err, ctxErr) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", ctxErr, err)
 }
 
-func decodeResponse(res *http.Response) (map[string]float64, error) {
+func readResponse(res *http.Response, info requestInfo, now time.Time) ([]byte, error) {
 	defer res.Body.Close()
 
 	jsonData, err := io.ReadAll(res.Body)
@@ -477,14 +636,27 @@ func decodeResponse(res *http.Response) (map[string]float64, error) {
 		return nil, fmt.Errorf("read response: %w", err)
 	}
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected response status %s: %s", res.Status, strings.TrimSpace(string(jsonData)))
+		return nil, newAPIError(res, jsonData, info, now)
+	}
+	return jsonData, nil
+}
+
+// decodeResponse decodes a symbol response into numeric and string values.
+// Null values are left out of the returned maps.
+func decodeResponse(jsonData []byte) (map[string]float64, map[string]string, error) {
+	var rawValues map[string]json.RawMessage
+	if err := json.Unmarshal(jsonData, &rawValues); err != nil {
+		return nil, nil, newDecodeError(jsonData, err)
 	}
 
-	responseMap := make(map[string]float64)
-	if err := json.Unmarshal(jsonData, &responseMap); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
+	responseMap := make(map[string]float64, len(rawValues))
+	var strs map[string]string
+	for column, raw := range rawValues {
+		if err := decodeValue(column, raw, responseMap, &strs); err != nil {
+			return nil, nil, err
+		}
 	}
-	return responseMap, nil
+	return responseMap, strs, nil
 }
 
 func (c *Client) baseURL() string {
@@ -512,111 +684,186 @@ func (c *Client) httpClient() *http.Client {
 	return defaultHTTPClient
 }
 
-func (ta *TradingView) populate(responseMap map[string]float64, dataInterval string) {
-	ta.populateGlobal(responseMap, dataInterval)
-	ta.populateOscillators(responseMap, dataInterval)
-	ta.populateMovingAverages(responseMap, dataInterval)
+func (ta *TradingView) populate(responseMap map[string]float64, dataInterval string, rules *RuleSet) {
+	*ta = TradingView{Raw: make(map[string]float64, len(responseMap))}
+	for _, field := range fieldsForInterval("") {
+		if v, ok := responseMap[field+dataInterval]; ok {
+			ta.Raw[field] = v
+		} else {
+			ta.Missing = append(ta.Missing, field)
+		}
+	}
+
+	ta.populateGlobal(responseMap, dataInterval, rules)
+	ta.populateOscillators(responseMap, dataInterval, rules)
+	ta.populateMovingAverages(responseMap, dataInterval, rules)
 	ta.populatePivots(responseMap, dataInterval)
 	ta.populatePrices(responseMap, dataInterval)
 }
 
-func (ta *TradingView) populateGlobal(responseMap map[string]float64, dataInterval string) {
-	ta.Recommend.Global.Summary = tvComputeRecommend(responseMap[key("Recommend.All%s", dataInterval)])
+// setSource records what ta was fetched for. It must be called after
+// populate, which clears it.
+func (ta *TradingView) setSource(symbol string, interval Interval, fetchedAt time.Time) {
+	ta.Symbol = symbol
+	ta.Interval = canonicalInterval(interval)
+	ta.FetchedAt = fetchedAt
+}
+
+func (ta *TradingView) populateGlobal(responseMap map[string]float64, dataInterval string, rules *RuleSet) {
+	if present(responseMap, dataInterval, "Recommend.All%s") {
+		ta.Recommend.Global.Summary = rules.recommend(responseMap[key("Recommend.All%s", dataInterval)])
+	}
 	ta.Value.Global.Summary = responseMap[key("Recommend.All%s", dataInterval)]
 
-	ta.Recommend.Global.Oscillators = tvComputeRecommend(responseMap[key("Recommend.Other%s", dataInterval)])
+	if present(responseMap, dataInterval, "Recommend.Other%s") {
+		ta.Recommend.Global.Oscillators = rules.recommend(responseMap[key("Recommend.Other%s", dataInterval)])
+	}
 	ta.Value.Global.Oscillators = responseMap[key("Recommend.Other%s", dataInterval)]
 
-	ta.Recommend.Global.MA = tvComputeRecommend(responseMap[key("Recommend.MA%s", dataInterval)])
+	if present(responseMap, dataInterval, "Recommend.MA%s") {
+		ta.Recommend.Global.MA = rules.recommend(responseMap[key("Recommend.MA%s", dataInterval)])
+	}
 	ta.Value.Global.MA = responseMap[key("Recommend.MA%s", dataInterval)]
 }
 
-func (ta *TradingView) populateOscillators(responseMap map[string]float64, dataInterval string) {
-	ta.Recommend.Oscillators.RSI = tvRSI(responseMap[key("RSI%s", dataInterval)], responseMap[key("RSI[1]%s", dataInterval)])
+func (ta *TradingView) populateOscillators(responseMap map[string]float64, dataInterval string, rules *RuleSet) {
+	if present(responseMap, dataInterval, "RSI%s", "RSI[1]%s") {
+		ta.Recommend.Oscillators.RSI = rules.rsi(responseMap[key("RSI%s", dataInterval)], responseMap[key("RSI[1]%s", dataInterval)])
+	}
 	ta.Value.Oscillators.RSI = responseMap[key("RSI%s", dataInterval)]
 
-	ta.Recommend.Oscillators.StochK = tvStoch(responseMap[key("Stoch.K%s", dataInterval)], responseMap[key("Stoch.D%s", dataInterval)], responseMap[key("Stoch.K[1]%s", dataInterval)], responseMap[key("Stoch.D[1]%s", dataInterval)])
+	if present(responseMap, dataInterval, "Stoch.K%s", "Stoch.D%s", "Stoch.K[1]%s", "Stoch.D[1]%s") {
+		ta.Recommend.Oscillators.StochK = rules.stoch(responseMap[key("Stoch.K%s", dataInterval)], responseMap[key("Stoch.D%s", dataInterval)], responseMap[key("Stoch.K[1]%s", dataInterval)], responseMap[key("Stoch.D[1]%s", dataInterval)])
+	}
 	ta.Value.Oscillators.StochK = responseMap[key("Stoch.K%s", dataInterval)]
 
-	ta.Recommend.Oscillators.CCI = tvCCI20(responseMap[key("CCI20%s", dataInterval)], responseMap[key("CCI20[1]%s", dataInterval)])
+	if present(responseMap, dataInterval, "CCI20%s", "CCI20[1]%s") {
+		ta.Recommend.Oscillators.CCI = rules.cci(responseMap[key("CCI20%s", dataInterval)], responseMap[key("CCI20[1]%s", dataInterval)])
+	}
 	ta.Value.Oscillators.CCI = responseMap[key("CCI20%s", dataInterval)]
 
-	ta.Recommend.Oscillators.ADX = tvADX(responseMap[key("ADX%s", dataInterval)], responseMap[key("ADX+DI%s", dataInterval)], responseMap[key("ADX-DI%s", dataInterval)], responseMap[key("ADX+DI[1]%s", dataInterval)], responseMap[key("ADX-DI[1]%s", dataInterval)])
+	if present(responseMap, dataInterval, "ADX%s", "ADX+DI%s", "ADX-DI%s", "ADX+DI[1]%s", "ADX-DI[1]%s") {
+		ta.Recommend.Oscillators.ADX = rules.adx(responseMap[key("ADX%s", dataInterval)], responseMap[key("ADX+DI%s", dataInterval)], responseMap[key("ADX-DI%s", dataInterval)], responseMap[key("ADX+DI[1]%s", dataInterval)], responseMap[key("ADX-DI[1]%s", dataInterval)])
+	}
 	ta.Value.Oscillators.ADX.Value = responseMap[key("ADX%s", dataInterval)]
 	ta.Value.Oscillators.ADX.PlusDI = responseMap[key("ADX+DI%s", dataInterval)]
 	ta.Value.Oscillators.ADX.MinusDI = responseMap[key("ADX-DI%s", dataInterval)]
 	ta.Value.Oscillators.ADX.PlusDI1 = responseMap[key("ADX+DI[1]%s", dataInterval)]
 	ta.Value.Oscillators.ADX.MinusDI1 = responseMap[key("ADX-DI[1]%s", dataInterval)]
 
-	ta.Recommend.Oscillators.AO = tvAO(responseMap[key("AO%s", dataInterval)], responseMap[key("AO[1]%s", dataInterval)], responseMap[key("AO[2]%s", dataInterval)])
+	if present(responseMap, dataInterval, "AO%s", "AO[1]%s", "AO[2]%s") {
+		ta.Recommend.Oscillators.AO = rules.ao(responseMap[key("AO%s", dataInterval)], responseMap[key("AO[1]%s", dataInterval)], responseMap[key("AO[2]%s", dataInterval)])
+	}
 	ta.Value.Oscillators.AO.Value = responseMap[key("AO%s", dataInterval)]
 	ta.Value.Oscillators.AO.Prev1 = responseMap[key("AO[1]%s", dataInterval)]
 	ta.Value.Oscillators.AO.Prev2 = responseMap[key("AO[2]%s", dataInterval)]
 
-	ta.Recommend.Oscillators.Mom = tvMom(responseMap[key("Mom%s", dataInterval)], responseMap[key("Mom[1]%s", dataInterval)])
+	if present(responseMap, dataInterval, "Mom%s", "Mom[1]%s") {
+		ta.Recommend.Oscillators.Mom = rules.mom(responseMap[key("Mom%s", dataInterval)], responseMap[key("Mom[1]%s", dataInterval)])
+	}
 	ta.Value.Oscillators.Mom = responseMap[key("Mom%s", dataInterval)]
 
-	ta.Recommend.Oscillators.MACD = tvMACD(responseMap[key("MACD.macd%s", dataInterval)], responseMap[key("MACD.signal%s", dataInterval)])
+	if present(responseMap, dataInterval, "MACD.macd%s", "MACD.signal%s") {
+		ta.Recommend.Oscillators.MACD = rules.macd(responseMap[key("MACD.macd%s", dataInterval)], responseMap[key("MACD.signal%s", dataInterval)])
+	}
 	ta.Value.Oscillators.MACD.Macd = responseMap[key("MACD.macd%s", dataInterval)]
 	ta.Value.Oscillators.MACD.Signal = responseMap[key("MACD.signal%s", dataInterval)]
 
-	ta.Recommend.Oscillators.StochRSI = tvSimple(responseMap[key("Rec.Stoch.RSI%s", dataInterval)])
+	if present(responseMap, dataInterval, "Rec.Stoch.RSI%s") {
+		ta.Recommend.Oscillators.StochRSI = rules.simple(responseMap[key("Rec.Stoch.RSI%s", dataInterval)])
+	}
 	ta.Value.Oscillators.StochRSI = responseMap[key("Stoch.RSI.K%s", dataInterval)]
 
-	ta.Recommend.Oscillators.WR = tvSimple(responseMap[key("Rec.WR%s", dataInterval)])
+	if present(responseMap, dataInterval, "Rec.WR%s") {
+		ta.Recommend.Oscillators.WR = rules.simple(responseMap[key("Rec.WR%s", dataInterval)])
+	}
 	ta.Value.Oscillators.WR = responseMap[key("W.R%s", dataInterval)]
 
-	ta.Recommend.Oscillators.BBP = tvSimple(responseMap[key("Rec.BBPower%s", dataInterval)])
+	if present(responseMap, dataInterval, "Rec.BBPower%s") {
+		ta.Recommend.Oscillators.BBP = rules.simple(responseMap[key("Rec.BBPower%s", dataInterval)])
+	}
 	ta.Value.Oscillators.BBP = responseMap[key("BBPower%s", dataInterval)]
 
-	ta.Recommend.Oscillators.UO = tvSimple(responseMap[key("Rec.UO%s", dataInterval)])
+	if present(responseMap, dataInterval, "Rec.UO%s") {
+		ta.Recommend.Oscillators.UO = rules.simple(responseMap[key("Rec.UO%s", dataInterval)])
+	}
 	ta.Value.Oscillators.UO = responseMap[key("UO%s", dataInterval)]
 }
 
-func (ta *TradingView) populateMovingAverages(responseMap map[string]float64, dataInterval string) {
-	ta.Recommend.MovingAverages.EMA10 = tvMA(responseMap[key("EMA10%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+func (ta *TradingView) populateMovingAverages(responseMap map[string]float64, dataInterval string, rules *RuleSet) {
+	if present(responseMap, dataInterval, "EMA10%s", "close%s") {
+		ta.Recommend.MovingAverages.EMA10 = rules.ma(responseMap[key("EMA10%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.EMA10 = responseMap[key("EMA10%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.EMA20 = tvMA(responseMap[key("EMA20%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "EMA20%s", "close%s") {
+		ta.Recommend.MovingAverages.EMA20 = rules.ma(responseMap[key("EMA20%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.EMA20 = responseMap[key("EMA20%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.EMA30 = tvMA(responseMap[key("EMA30%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "EMA30%s", "close%s") {
+		ta.Recommend.MovingAverages.EMA30 = rules.ma(responseMap[key("EMA30%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.EMA30 = responseMap[key("EMA30%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.EMA50 = tvMA(responseMap[key("EMA50%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "EMA50%s", "close%s") {
+		ta.Recommend.MovingAverages.EMA50 = rules.ma(responseMap[key("EMA50%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.EMA50 = responseMap[key("EMA50%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.EMA100 = tvMA(responseMap[key("EMA100%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "EMA100%s", "close%s") {
+		ta.Recommend.MovingAverages.EMA100 = rules.ma(responseMap[key("EMA100%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.EMA100 = responseMap[key("EMA100%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.EMA200 = tvMA(responseMap[key("EMA200%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "EMA200%s", "close%s") {
+		ta.Recommend.MovingAverages.EMA200 = rules.ma(responseMap[key("EMA200%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.EMA200 = responseMap[key("EMA200%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.SMA10 = tvMA(responseMap[key("SMA10%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "SMA10%s", "close%s") {
+		ta.Recommend.MovingAverages.SMA10 = rules.ma(responseMap[key("SMA10%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.SMA10 = responseMap[key("SMA10%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.SMA20 = tvMA(responseMap[key("SMA20%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "SMA20%s", "close%s") {
+		ta.Recommend.MovingAverages.SMA20 = rules.ma(responseMap[key("SMA20%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.SMA20 = responseMap[key("SMA20%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.SMA30 = tvMA(responseMap[key("SMA30%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "SMA30%s", "close%s") {
+		ta.Recommend.MovingAverages.SMA30 = rules.ma(responseMap[key("SMA30%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.SMA30 = responseMap[key("SMA30%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.SMA50 = tvMA(responseMap[key("SMA50%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "SMA50%s", "close%s") {
+		ta.Recommend.MovingAverages.SMA50 = rules.ma(responseMap[key("SMA50%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.SMA50 = responseMap[key("SMA50%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.SMA100 = tvMA(responseMap[key("SMA100%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "SMA100%s", "close%s") {
+		ta.Recommend.MovingAverages.SMA100 = rules.ma(responseMap[key("SMA100%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.SMA100 = responseMap[key("SMA100%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.SMA200 = tvMA(responseMap[key("SMA200%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	if present(responseMap, dataInterval, "SMA200%s", "close%s") {
+		ta.Recommend.MovingAverages.SMA200 = rules.ma(responseMap[key("SMA200%s", dataInterval)], responseMap[key("close%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.SMA200 = responseMap[key("SMA200%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.Ichimoku = tvSimple(responseMap[key("Rec.Ichimoku%s", dataInterval)])
+	if present(responseMap, dataInterval, "Rec.Ichimoku%s") {
+		ta.Recommend.MovingAverages.Ichimoku = rules.simple(responseMap[key("Rec.Ichimoku%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.Ichimoku = responseMap[key("Ichimoku.BLine%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.VWMA = tvSimple(responseMap[key("Rec.VWMA%s", dataInterval)])
+	if present(responseMap, dataInterval, "Rec.VWMA%s") {
+		ta.Recommend.MovingAverages.VWMA = rules.simple(responseMap[key("Rec.VWMA%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.VWMA = responseMap[key("VWMA%s", dataInterval)]
 
-	ta.Recommend.MovingAverages.HullMA = tvSimple(responseMap[key("Rec.HullMA9%s", dataInterval)])
+	if present(responseMap, dataInterval, "Rec.HullMA9%s") {
+		ta.Recommend.MovingAverages.HullMA = rules.simple(responseMap[key("Rec.HullMA9%s", dataInterval)])
+	}
 	ta.Value.MovingAverages.HullMA = responseMap[key("HullMA9%s", dataInterval)]
 }
 
@@ -664,23 +911,40 @@ func (ta *TradingView) populatePrices(responseMap map[string]float64, dataInterv
 	ta.Value.Prices.Low = responseMap[key("low%s", dataInterval)]
 }
 
+// present reports whether responseMap holds a value for every indicator.
+func present(responseMap map[string]float64, dataInterval string, indicators ...string) bool {
+	for _, indicator := range indicators {
+		if _, ok := responseMap[key(indicator, dataInterval)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // key formats a response key from an indicator pattern and interval suffix.
 func key(indicator, dataInterval string) string {
 	return fmt.Sprintf(indicator, dataInterval)
 }
 
 // tvComputeRecommend converts TradingView's aggregate score into a public signal.
-func tvComputeRecommend(v float64) int {
+func tvComputeRecommend(v float64) Signal {
+	r := DefaultRules()
+	return computeRecommend(v, r.StrongSell, r.Sell, r.Buy, r.StrongBuy)
+}
+
+// computeRecommend converts an aggregate score between -1 and 1 into a
+// signal using the given band edges.
+func computeRecommend(v, strongSell, sell, buy, strongBuy float64) Signal {
 	switch {
-	case v > 0.1 && v <= 0.5:
+	case v > buy && v <= strongBuy:
 		return SignalBuy
-	case v > 0.5 && v <= 1:
+	case v > strongBuy && v <= 1:
 		return SignalStrongBuy
-	case v >= -0.1 && v <= 0.1:
+	case v >= sell && v <= buy:
 		return SignalNeutral
-	case v >= -1 && v < -0.5:
+	case v >= -1 && v < strongSell:
 		return SignalStrongSell
-	case v >= -0.5 && v < -0.1:
+	case v >= strongSell && v < sell:
 		return SignalSell
 	default:
 		return SignalNeutral
@@ -688,11 +952,17 @@ func tvComputeRecommend(v float64) int {
 }
 
 // tvRSI converts RSI values into a normalized recommendation signal.
-func tvRSI(rsi, rsi1 float64) int {
+func tvRSI(rsi, rsi1 float64) Signal {
+	r := DefaultRules()
+	return rsiRule(rsi, rsi1, r.RSIOversold, r.RSIOverbought)
+}
+
+// rsiRule converts RSI values into a signal using the given thresholds.
+func rsiRule(rsi, rsi1, oversold, overbought float64) Signal {
 	switch {
-	case rsi < 30 && rsi1 < rsi:
+	case rsi < oversold && rsi1 < rsi:
 		return SignalBuy
-	case rsi > 70 && rsi1 > rsi:
+	case rsi > overbought && rsi1 > rsi:
 		return SignalSell
 	default:
 		return SignalNeutral
@@ -700,11 +970,17 @@ func tvRSI(rsi, rsi1 float64) int {
 }
 
 // tvStoch converts stochastic values into a normalized recommendation signal.
-func tvStoch(k, d, k1, d1 float64) int {
+func tvStoch(k, d, k1, d1 float64) Signal {
+	r := DefaultRules()
+	return stochRule(k, d, k1, d1, r.StochOversold, r.StochOverbought)
+}
+
+// stochRule converts stochastic values into a signal using the given thresholds.
+func stochRule(k, d, k1, d1, oversold, overbought float64) Signal {
 	switch {
-	case k < 20 && d < 20 && k > d && k1 < d1:
+	case k < oversold && d < oversold && k > d && k1 < d1:
 		return SignalBuy
-	case k > 80 && d > 80 && k < d && k1 > d1:
+	case k > overbought && d > overbought && k < d && k1 > d1:
 		return SignalSell
 	default:
 		return SignalNeutral
@@ -712,11 +988,17 @@ func tvStoch(k, d, k1, d1 float64) int {
 }
 
 // tvCCI20 converts CCI values into a normalized recommendation signal.
-func tvCCI20(cci20, cci201 float64) int {
+func tvCCI20(cci20, cci201 float64) Signal {
+	r := DefaultRules()
+	return cciRule(cci20, cci201, r.CCIOversold, r.CCIOverbought)
+}
+
+// cciRule converts CCI values into a signal using the given thresholds.
+func cciRule(cci20, cci201, oversold, overbought float64) Signal {
 	switch {
-	case cci20 < -100 && cci20 > cci201:
+	case cci20 < oversold && cci20 > cci201:
 		return SignalBuy
-	case cci20 > 100 && cci20 < cci201:
+	case cci20 > overbought && cci20 < cci201:
 		return SignalSell
 	default:
 		return SignalNeutral
@@ -724,11 +1006,16 @@ func tvCCI20(cci20, cci201 float64) int {
 }
 
 // tvADX converts ADX values into a normalized recommendation signal.
-func tvADX(adx, adxpdi, adxndi, adxpdi1, adxndi1 float64) int {
+func tvADX(adx, adxpdi, adxndi, adxpdi1, adxndi1 float64) Signal {
+	return adxRule(adx, adxpdi, adxndi, adxpdi1, adxndi1, DefaultRules().ADXTrend)
+}
+
+// adxRule converts ADX values into a signal using the given trend threshold.
+func adxRule(adx, adxpdi, adxndi, adxpdi1, adxndi1, trend float64) Signal {
 	switch {
-	case adx > 20 && adxpdi1 < adxndi1 && adxpdi > adxndi:
+	case adx > trend && adxpdi1 < adxndi1 && adxpdi > adxndi:
 		return SignalBuy
-	case adx > 20 && adxpdi1 > adxndi1 && adxpdi < adxndi:
+	case adx > trend && adxpdi1 > adxndi1 && adxpdi < adxndi:
 		return SignalSell
 	default:
 		return SignalNeutral
@@ -736,7 +1023,7 @@ func tvADX(adx, adxpdi, adxndi, adxpdi1, adxndi1 float64) int {
 }
 
 // tvAO converts Awesome Oscillator values into a normalized recommendation signal.
-func tvAO(ao, ao1, ao2 float64) int {
+func tvAO(ao, ao1, ao2 float64) Signal {
 	switch {
 	case (ao > 0 && ao1 < 0) || (ao > 0 && ao1 > 0 && ao > ao1 && ao2 > ao1):
 		return SignalBuy
@@ -748,7 +1035,7 @@ func tvAO(ao, ao1, ao2 float64) int {
 }
 
 // tvMom converts momentum values into a normalized recommendation signal.
-func tvMom(mom, mom1 float64) int {
+func tvMom(mom, mom1 float64) Signal {
 	switch {
 	case mom > mom1:
 		return SignalBuy
@@ -760,7 +1047,7 @@ func tvMom(mom, mom1 float64) int {
 }
 
 // tvMACD converts MACD values into a normalized recommendation signal.
-func tvMACD(macd, s float64) int {
+func tvMACD(macd, s float64) Signal {
 	switch {
 	case macd > s:
 		return SignalBuy
@@ -772,7 +1059,7 @@ func tvMACD(macd, s float64) int {
 }
 
 // tvSimple converts a TradingView simple recommendation value into a signal.
-func tvSimple(v float64) int {
+func tvSimple(v float64) Signal {
 	switch {
 	case v == 1:
 		return SignalBuy
@@ -784,7 +1071,7 @@ func tvSimple(v float64) int {
 }
 
 // tvMA converts a moving average and close price into a signal.
-func tvMA(ma, close float64) int {
+func tvMA(ma, close float64) Signal {
 	switch {
 	case ma < close:
 		return SignalBuy
@@ -5,12 +5,16 @@
 package tradingview
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestTradingView_GetAllIntervals(t *testing.T) {
@@ -19,7 +23,7 @@ func TestTradingView_GetAllIntervals(t *testing.T) {
 
 	intervals := []struct {
 		name     string
-		interval string
+		interval Interval
 	}{
 		{"Interval1min", Interval1Min},
 		{"Interval5min", Interval5Min},
@@ -82,7 +86,7 @@ func TestTradingView_GetParsesResponse(t *testing.T) {
 			"ADX-DI|60",
 			"close|60",
 		} {
-			if !contains(fields, expected) {
+			if !slices.Contains(fields, expected) {
 				http.Error(w, "missing expected field "+expected, http.StatusBadRequest)
 				return
 			}
@@ -157,6 +161,62 @@ func TestTradingView_GetUnexpectedStatus(t *testing.T) {
 	}
 }
 
+func TestClient_GetContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer server.Close()
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ta := &TradingView{}
+	err := client.GetContext(ctx, ta, "BINANCE:BTCUSDT", Interval1Hour)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestClient_GetContextDeadline(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	ta := &TradingView{}
+	err := client.GetContext(ctx, ta, "BINANCE:BTCUSDT", Interval1Hour)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestTradingView_GetContextNilReceiver(t *testing.T) {
+	var ta *TradingView
+
+	err := ta.GetContext(context.Background(), "BINANCE:BTCUSDT", Interval1Hour)
+	if !errors.Is(err, ErrNilTradingView) {
+		t.Fatalf("expected ErrNilTradingView, got %v", err)
+	}
+}
+
 func Test_key(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -177,15 +237,6 @@ func Test_key(t *testing.T) {
 	}
 }
 
-func contains(values []string, target string) bool {
-	for _, value := range values {
-		if value == target {
-			return true
-		}
-	}
-	return false
-}
-
 func Test_tvComputeRecommend(t *testing.T) {
 	type args struct {
 		v float64
@@ -193,7 +244,7 @@ func Test_tvComputeRecommend(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Strong Buy", args{0.6}, SignalStrongBuy},      // v between 0.5 and 1
 		{"Test Buy", args{0.3}, SignalBuy},                   // v between 0.1 and 0.5
@@ -220,7 +271,7 @@ func Test_tvRSI(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{29, 28}, SignalBuy},         // RSI < 30 and rsi1 < rsi
 		{"Test Sell", args{71, 72}, SignalSell},       // RSI > 70 and rsi1 > rsi
@@ -245,7 +296,7 @@ func Test_tvStoch(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{19, 18, 17, 18}, SignalBuy},         // k, d < 20, k > d, k1 < d1
 		{"Test Sell", args{81, 82, 83, 82}, SignalSell},       // k, d > 80, k < d, k1 > d1
@@ -268,7 +319,7 @@ func Test_tvCCI20(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{-101, -102}, SignalBuy},   // CCI20 < -100 and CCI20 > CCI201
 		{"Test Sell", args{101, 102}, SignalSell},   // CCI20 > 100 and CCI20 < CCI201
@@ -294,7 +345,7 @@ func Test_tvADX(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{21, 30, 20, 19, 21}, SignalBuy},         // ADX > 20, adxpdi1 < adxndi1, adxpdi > adxndi
 		{"Test Sell", args{21, 20, 30, 31, 29}, SignalSell},       // ADX > 20, adxpdi1 > adxndi1, adxpdi < adxndi
@@ -318,7 +369,7 @@ func Test_tvAO(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{1, -1, 0}, SignalBuy},        // AO > 0, AO1 < 0
 		{"Test Sell", args{-1, 1, 0}, SignalSell},      // AO < 0, AO1 > 0
@@ -341,7 +392,7 @@ func Test_tvMom(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{2, 1}, SignalBuy},         // mom > mom1
 		{"Test Sell", args{1, 2}, SignalSell},       // mom < mom1
@@ -364,7 +415,7 @@ func Test_tvMACD(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{1, 0}, SignalBuy},         // MACD > Signal
 		{"Test Sell", args{0, 1}, SignalSell},       // MACD < Signal
@@ -386,7 +437,7 @@ func Test_tvSimple(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{1}, SignalBuy},         // v == 1
 		{"Test Sell", args{-1}, SignalSell},      // v == -1
@@ -409,7 +460,7 @@ func Test_tvMA(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want int
+		want Signal
 	}{
 		{"Test Buy", args{100, 101}, SignalBuy},         // ma < close
 		{"Test Sell", args{101, 100}, SignalSell},       // ma > close
@@ -423,3 +474,124 @@ func Test_tvMA(t *testing.T) {
 		})
 	}
 }
+
+func TestTradingView_GetSetsSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"close|60": 1, "close": 2}`))
+	}))
+	defer server.Close()
+
+	client := Client{HTTPClient: server.Client(), BaseURL: server.URL}
+
+	before := time.Now()
+	var ta TradingView
+	if err := client.Get(&ta, " binance:btcusdt ", "1h"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ta.Symbol != "BINANCE:BTCUSDT" || ta.Interval != Interval1Hour {
+		t.Fatalf("unexpected source %q %q", ta.Symbol, ta.Interval)
+	}
+	if ta.FetchedAt.Before(before) || ta.FetchedAt.After(time.Now()) {
+		t.Fatalf("unexpected FetchedAt %v", ta.FetchedAt)
+	}
+
+	if err := client.Get(&ta, "BINANCE:BTCUSDT", ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ta.Interval != Interval1Day {
+		t.Fatalf("Interval = %q, want %q", ta.Interval, Interval1Day)
+	}
+
+	fetchedAt := ta.FetchedAt
+	ta.Recompute(nil)
+	if ta.Symbol != "BINANCE:BTCUSDT" || ta.Interval != Interval1Day || !ta.FetchedAt.Equal(fetchedAt) {
+		t.Fatalf("Recompute dropped the source: %q %q %v", ta.Symbol, ta.Interval, ta.FetchedAt)
+	}
+}
+
+func TestTradingView_JSONRoundTrip(t *testing.T) {
+	ta := FromFields(map[string]float64{
+		"Recommend.All":      0.6,
+		"RSI":                25,
+		"RSI[1]":             20,
+		"ADX":                30,
+		"ADX+DI":             25,
+		"ADX-DI":             10,
+		"ADX+DI[1]":          20,
+		"ADX-DI[1]":          15,
+		"SMA200":             90,
+		"close":              100,
+		"Pivot.M.Classic.R1": 110.5,
+	})
+	ta.Symbol = "BINANCE:BTCUSDT"
+	ta.Interval = Interval4Hour
+	ta.FetchedAt = time.Date(2026, 1, 2, 15, 4, 5, 6, time.UTC)
+	ta.Raw["volume"] = 1234
+	ta.Strings = map[string]string{"description": "Bitcoin / TetherUS"}
+
+	data, err := json.Marshal(ta)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var got TradingView
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !reflect.DeepEqual(&got, ta) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", got, *ta)
+	}
+	if got.Recompute(nil); !reflect.DeepEqual(got.Recommend, ta.Recommend) {
+		t.Fatalf("Recompute after decoding changed signals: %+v", got.Recommend)
+	}
+}
+
+func TestTradingView_JSONSchema(t *testing.T) {
+	ta := &TradingView{Symbol: "BINANCE:BTCUSDT", Interval: Interval1Hour}
+	ta.Recommend.Global.Summary = SignalStrongBuy
+	ta.Recommend.MovingAverages.HullMA = SignalSell
+	ta.Value.Oscillators.ADX.PlusDI1 = 12.5
+	ta.Value.Pivots.Classic.R1 = 110.5
+
+	data, err := json.Marshal(ta)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		path string
+		want any
+	}{
+		{"symbol", "BINANCE:BTCUSDT"},
+		{"interval", "60"},
+		{"recommend.global.summary", "STRONG_BUY"},
+		{"recommend.oscillators.stoch_rsi", "NEUTRAL"},
+		{"recommend.moving_averages.hull_ma", "SELL"},
+		{"value.global.ma", 0.0},
+		{"value.oscillators.adx.plus_di1", 12.5},
+		{"value.oscillators.macd.signal", 0.0},
+		{"value.moving_averages.sma200", 0.0},
+		{"value.pivots.classic.r1", 110.5},
+		{"value.prices.close", 0.0},
+	}
+	for _, tt := range tests {
+		var v any = doc
+		for key := range strings.SplitSeq(tt.path, ".") {
+			m, _ := v.(map[string]any)
+			v = m[key]
+		}
+		if v != tt.want {
+			t.Errorf("%s = %v, want %v", tt.path, v, tt.want)
+		}
+	}
+
+	for _, key := range []string{"fetched_at", "missing", "raw", "strings"} {
+		if _, ok := doc[key]; ok {
+			t.Errorf("expected empty %s to be omitted", key)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tvtest_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+	"github.com/artlevitan/go-tradingview-ta/tvtest"
+)
+
+func ExampleReplayer() {
+	// Stand in for the scanner while recording.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"Recommend.All|60": 0.3, "Recommend.MA|60": 0.8, "close|60": 70998.71}`)
+	}))
+	defer server.Close()
+
+	recorder := &tvtest.Recorder{Transport: server.Client().Transport}
+	client := tradingview.Client{
+		HTTPClient: &http.Client{Transport: recorder},
+		BaseURL:    server.URL + "/symbol",
+	}
+	var ta tradingview.TradingView
+	if err := client.Get(&ta, "BINANCE:BTCUSDT", tradingview.Interval1Hour); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	// Tests would save the fixtures with tvtest.Save and load them with
+	// tvtest.Replay; the replaying client needs no server.
+	client = tradingview.Client{
+		HTTPClient: &http.Client{Transport: tvtest.NewReplayer(recorder.Fixtures()...)},
+	}
+	var replayed tradingview.TradingView
+	if err := client.Get(&replayed, "BINANCE:BTCUSDT", tradingview.Interval1Hour); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(replayed.Recommend.Global.Summary, replayed.Value.Prices.Close)
+
+	err := client.Get(&replayed, "BINANCE:ETHUSDT", tradingview.Interval1Hour)
+	fmt.Println(err != nil)
+	// Output:
+	// BUY 70998.71
+	// true
+}
@@ -0,0 +1,100 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tvtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// Recorder is an http.RoundTripper that sends requests with Transport and
+// records each request and response as a Fixture.
+//
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	// Transport sends the requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	fixtures []Fixture
+}
+
+// Record returns a Recorder using transport that saves its fixtures to the
+// file at path when tb and its subtests complete. The test fails if they
+// cannot be saved.
+func Record(tb testing.TB, path string, transport http.RoundTripper) *Recorder {
+	tb.Helper()
+	r := &Recorder{Transport: transport}
+	tb.Cleanup(func() {
+		if err := Save(path, r.Fixtures()); err != nil {
+			tb.Errorf("tvtest: save fixtures: %v", err)
+		}
+	})
+	return r
+}
+
+// Fixtures returns the fixtures recorded so far, in the order the
+// responses arrived.
+func (r *Recorder) Fixtures() []Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fixtures := make([]Fixture, len(r.fixtures))
+	copy(fixtures, r.fixtures)
+	return fixtures
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	k, body, err := requestKey(req)
+	if err != nil {
+		return nil, fmt.Errorf("tvtest: read request body: %w", err)
+	}
+	out := req.Clone(req.Context())
+	if body != nil {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+		out.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	f := Fixture{
+		Method:      k.method,
+		Path:        k.path,
+		Symbol:      k.symbol,
+		Fields:      k.fields,
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if json.Valid(data) {
+		f.JSON = bytes.Clone(data)
+	} else {
+		f.Body = string(data)
+	}
+
+	r.mu.Lock()
+	r.fixtures = append(r.fixtures, f)
+	r.mu.Unlock()
+	return resp, nil
+}
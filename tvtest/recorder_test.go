@@ -0,0 +1,91 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tvtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// scanner serves a fixed response for BINANCE:BTCUSDT and 404 for
+// anything else.
+func scanner(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("symbol") != "BINANCE:BTCUSDT" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Recommend.All|60": 0.6, "close|60": 70998.71}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRecorder(t *testing.T) {
+	server := scanner(t)
+	rec := &Recorder{Transport: server.Client().Transport}
+	client := tradingview.Client{
+		HTTPClient: &http.Client{Transport: rec},
+		BaseURL:    server.URL + "/symbol",
+	}
+
+	var ta tradingview.TradingView
+	if err := client.GetContext(context.Background(), &ta, "BINANCE:BTCUSDT", tradingview.Interval1Hour); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ta.Value.Prices.Close != 70998.71 {
+		t.Fatalf("expected the response to reach the client, got %+v", ta.Value.Prices)
+	}
+	if err := client.GetContext(context.Background(), &ta, "BINANCE:NOPE", tradingview.Interval1Hour); err == nil {
+		t.Fatal("expected an error for an unknown symbol")
+	}
+
+	fixtures := rec.Fixtures()
+	if len(fixtures) != 2 {
+		t.Fatalf("expected 2 fixtures, got %d", len(fixtures))
+	}
+	ok, notFound := fixtures[0], fixtures[1]
+	if ok.Method != "GET" || ok.Path != "/symbol" || ok.Symbol != "BINANCE:BTCUSDT" || ok.Status != 200 ||
+		ok.ContentType != "application/json" || string(ok.JSON) != `{"Recommend.All|60": 0.6, "close|60": 70998.71}` {
+		t.Fatalf("unexpected fixture: %+v", ok)
+	}
+	if len(ok.Fields) == 0 || ok.Body != "" {
+		t.Fatalf("unexpected fields or body: %+v", ok)
+	}
+	if notFound.Status != 404 || notFound.JSON != nil || notFound.Body != "not found\n" {
+		t.Fatalf("unexpected fixture: %+v", notFound)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	server := scanner(t)
+	path := filepath.Join(t.TempDir(), "btcusdt.json")
+
+	t.Run("record", func(t *testing.T) {
+		client := tradingview.Client{
+			HTTPClient: &http.Client{Transport: Record(t, path, server.Client().Transport)},
+			BaseURL:    server.URL + "/symbol",
+		}
+		var ta tradingview.TradingView
+		if err := client.GetContext(context.Background(), &ta, "BINANCE:BTCUSDT", tradingview.Interval1Hour); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	fixtures, err := Load(path)
+	if err != nil {
+		t.Fatalf("expected the fixtures to be saved, got %v", err)
+	}
+	if len(fixtures) != 1 || fixtures[0].Symbol != "BINANCE:BTCUSDT" {
+		t.Fatalf("unexpected fixtures: %+v", fixtures)
+	}
+}
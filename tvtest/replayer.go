@@ -0,0 +1,145 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tvtest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// ErrNoFixture reports a request that matches no fixture.
+var ErrNoFixture = errors.New("tvtest: no fixture for request")
+
+// Replayer is an http.RoundTripper that serves fixtures instead of sending
+// requests. When several fixtures match a request, they are served in order
+// and the last one is repeated, so a recorded failure followed by a success
+// replays a retry.
+//
+// A Replayer is safe for concurrent use.
+type Replayer struct {
+	tb       testing.TB
+	mu       sync.Mutex
+	fixtures []Fixture
+	served   []int // times each fixture was served
+}
+
+// NewReplayer returns a Replayer serving fixtures. Requests without a
+// fixture fail with ErrNoFixture.
+func NewReplayer(fixtures ...Fixture) *Replayer {
+	return &Replayer{fixtures: fixtures, served: make([]int, len(fixtures))}
+}
+
+// Replay returns a Replayer serving the fixtures in the file at path. It
+// stops the test if the file cannot be read, and fails the test on every
+// request without a fixture, even if the caller ignores the error.
+func Replay(tb testing.TB, path string) *Replayer {
+	tb.Helper()
+	fixtures, err := Load(path)
+	if err != nil {
+		tb.Fatalf("tvtest: load fixtures: %v", err)
+	}
+	r := NewReplayer(fixtures...)
+	r.tb = tb
+	return r
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	k, _, err := requestKey(req)
+	if err != nil {
+		return nil, fmt.Errorf("tvtest: read request body: %w", err)
+	}
+
+	f, ok := r.next(k)
+	if !ok {
+		err := fmt.Errorf("%w: %s%s", ErrNoFixture, k, r.fieldsHint(k))
+		if r.tb != nil {
+			r.tb.Error(err)
+		}
+		return nil, err
+	}
+
+	body := []byte(f.Body)
+	if f.JSON != nil {
+		body = f.JSON
+	}
+	header := make(http.Header)
+	if f.ContentType != "" {
+		header.Set("Content-Type", f.ContentType)
+	}
+	return &http.Response{
+		Status:        strconv.Itoa(f.Status) + " " + http.StatusText(f.Status),
+		StatusCode:    f.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// next returns the fixture to serve for k.
+func (r *Replayer) next(k key) (Fixture, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last := -1
+	for i := range r.fixtures {
+		if !k.matches(&r.fixtures[i]) {
+			continue
+		}
+		if r.served[i] == 0 {
+			r.served[i]++
+			return r.fixtures[i], true
+		}
+		last = i
+	}
+	if last < 0 {
+		return Fixture{}, false
+	}
+	r.served[last]++
+	return r.fixtures[last], true
+}
+
+// fieldsHint describes how the fields of k differ from those of the first
+// fixture for the same symbol, if there is one.
+func (r *Replayer) fieldsHint(k key) string {
+	for i := range r.fixtures {
+		f := &r.fixtures[i]
+		if f.Method != k.method || f.Path != k.path || f.Symbol != k.symbol {
+			continue
+		}
+		var unexpected, missing []string
+		for _, field := range k.fields {
+			if !slices.Contains(f.Fields, field) {
+				unexpected = append(unexpected, field)
+			}
+		}
+		for _, field := range f.Fields {
+			if !slices.Contains(k.fields, field) {
+				missing = append(missing, field)
+			}
+		}
+		return fmt.Sprintf("; the fixture for this symbol lacks %s and has %s", firstFields(unexpected), firstFields(missing))
+	}
+	return ""
+}
+
+// firstFields lists the first few fields.
+func firstFields(fields []string) string {
+	const n = 3
+	if len(fields) <= n {
+		return fmt.Sprintf("%q", fields)
+	}
+	return fmt.Sprintf("%q and %d more", fields[:n], len(fields)-n)
+}
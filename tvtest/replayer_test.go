@@ -0,0 +1,119 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tvtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tradingview "github.com/artlevitan/go-tradingview-ta"
+)
+
+// recordBTC records the response of the scanner test server for
+// BINANCE:BTCUSDT at one hour.
+func recordBTC(t *testing.T) []Fixture {
+	t.Helper()
+	server := scanner(t)
+	rec := &Recorder{Transport: server.Client().Transport}
+	client := tradingview.Client{HTTPClient: &http.Client{Transport: rec}, BaseURL: server.URL + "/symbol"}
+	var ta tradingview.TradingView
+	if err := client.GetContext(context.Background(), &ta, "BINANCE:BTCUSDT", tradingview.Interval1Hour); err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	return rec.Fixtures()
+}
+
+func TestReplayer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "btcusdt.json")
+	if err := Save(path, recordBTC(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	// The default client points at TradingView; the host is ignored.
+	client := tradingview.Client{HTTPClient: &http.Client{Transport: Replay(t, path)}}
+	for range 2 {
+		var ta tradingview.TradingView
+		if err := client.GetContext(context.Background(), &ta, "binance:btcusdt", tradingview.Interval1Hour); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if ta.Recommend.Global.Summary != tradingview.SignalStrongBuy || ta.Value.Prices.Close != 70998.71 {
+			t.Fatalf("unexpected result: %+v", ta)
+		}
+	}
+}
+
+// recordingTB records the errors reported to it.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (tb *recordingTB) Error(args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprint(args...))
+}
+
+func TestReplayer_Unmatched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "btcusdt.json")
+	if err := Save(path, recordBTC(t)); err != nil {
+		t.Fatal(err)
+	}
+	tb := &recordingTB{TB: t}
+	client := tradingview.Client{HTTPClient: &http.Client{Transport: Replay(tb, path)}}
+
+	var ta tradingview.TradingView
+	err := client.GetContext(context.Background(), &ta, "BINANCE:ETHUSDT", tradingview.Interval1Hour)
+	if !errors.Is(err, ErrNoFixture) || !strings.Contains(err.Error(), `symbol="BINANCE:ETHUSDT"`) {
+		t.Fatalf("expected ErrNoFixture for the symbol, got %v", err)
+	}
+	err = client.GetContext(context.Background(), &ta, "BINANCE:BTCUSDT", tradingview.Interval4Hour)
+	if !errors.Is(err, ErrNoFixture) || !strings.Contains(err.Error(), `lacks ["ADX+DI[1]|240" "ADX+DI|240" "ADX-DI[1]|240"] and 80 more`) {
+		t.Fatalf("expected ErrNoFixture for the fields, got %v", err)
+	}
+	if len(tb.errors) != 2 {
+		t.Fatalf("expected the test to be failed twice, got %q", tb.errors)
+	}
+}
+
+func TestReplayer_Sequence(t *testing.T) {
+	fixtures := recordBTC(t)
+	unavailable := fixtures[0]
+	unavailable.JSON, unavailable.Body, unavailable.Status = nil, "busy", http.StatusServiceUnavailable
+
+	client := tradingview.Client{
+		HTTPClient: &http.Client{Transport: NewReplayer(unavailable, fixtures[0])},
+		Retry:      &tradingview.RetryPolicy{MaxAttempts: 2, BaseDelay: 1},
+	}
+	for range 2 {
+		var ta tradingview.TradingView
+		if err := client.GetContext(context.Background(), &ta, "BINANCE:BTCUSDT", tradingview.Interval1Hour); err != nil {
+			t.Fatalf("expected the retry to get the recorded success, got %v", err)
+		}
+	}
+}
+
+func TestReplayer_Scan(t *testing.T) {
+	replayer := NewReplayer(Fixture{
+		Method: "POST",
+		Path:   "/crypto/scan",
+		Symbol: "BINANCE:BTCUSDT,BINANCE:ETHUSDT",
+		Status: 200,
+		JSON:   []byte(`{"totalCount": 0, "data": []}`),
+	})
+	req, _ := http.NewRequest(http.MethodPost, "https://scanner.tradingview.com/crypto/scan",
+		strings.NewReader(`{"symbols":{"tickers":["BINANCE:BTCUSDT","BINANCE:ETHUSDT"]}}`))
+	resp, err := replayer.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 200 || resp.Request != req {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
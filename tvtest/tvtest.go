@@ -0,0 +1,154 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package tvtest records TradingView responses into fixture files and
+// replays them, so tests can use real scanner data without the network.
+//
+// Both the Recorder and the Replayer are http.RoundTrippers that plug into
+// tradingview.Client.HTTPClient:
+//
+//	client := tradingview.Client{
+//		HTTPClient: &http.Client{Transport: tvtest.Replay(t, "testdata/btcusdt.json")},
+//	}
+//
+// To create or refresh a fixture file, run the test once with a Recorder in
+// place of the Replayer:
+//
+//	client := tradingview.Client{
+//		HTTPClient: &http.Client{Transport: tvtest.Record(t, "testdata/btcusdt.json", nil)},
+//	}
+//
+// A fixture file is an indented JSON array of Fixtures, which can be
+// reviewed and edited by hand.
+//
+// Requests are matched by method, URL path, symbol and requested fields.
+// The symbol is the symbol query parameter of single-symbol requests, the
+// text parameter of symbol searches, or the comma-separated tickers of scan
+// requests; the fields are the fields parameter or the scan columns, in any
+// order. The host is ignored, so fixtures recorded against TradingView
+// replay for any BaseURL.
+package tvtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Fixture is a recorded request and its response.
+type Fixture struct {
+	// Method and Path identify the endpoint, such as GET /symbol.
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Symbol and Fields identify the request; see the package
+	// documentation.
+	Symbol string   `json:"symbol,omitempty"`
+	Fields []string `json:"fields,omitempty"`
+
+	// Status is the response status code.
+	Status int `json:"status"`
+	// ContentType is the Content-Type header of the response.
+	ContentType string `json:"content_type,omitempty"`
+	// JSON holds the response body if it is valid JSON, and Body holds it
+	// otherwise.
+	JSON json.RawMessage `json:"json,omitempty"`
+	Body string          `json:"body,omitempty"`
+}
+
+// Load reads fixtures from the file at path.
+func Load(path string) ([]Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fixtures []Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("tvtest: %s: %w", path, err)
+	}
+	return fixtures, nil
+}
+
+// Save writes fixtures to the file at path, creating its directory if
+// needed.
+func Save(path string, fixtures []Fixture) error {
+	if fixtures == nil {
+		fixtures = []Fixture{}
+	}
+	data, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// key identifies a request.
+type key struct {
+	method string
+	path   string
+	symbol string
+	fields []string // sorted
+}
+
+// requestKey returns the key of req and its body. It consumes and closes
+// req.Body.
+func requestKey(req *http.Request) (key, []byte, error) {
+	k := key{method: req.Method, path: req.URL.Path}
+	query := req.URL.Query()
+	k.symbol = query.Get("symbol")
+	if k.symbol == "" {
+		k.symbol = query.Get("text")
+	}
+	if fields := query.Get("fields"); fields != "" {
+		k.fields = strings.Split(fields, ",")
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return key{}, nil, err
+	}
+	if len(body) > 0 {
+		var scan struct {
+			Symbols struct {
+				Tickers []string `json:"tickers"`
+			} `json:"symbols"`
+			Columns []string `json:"columns"`
+		}
+		if err := json.Unmarshal(body, &scan); err == nil {
+			if k.symbol == "" {
+				k.symbol = strings.Join(scan.Symbols.Tickers, ",")
+			}
+			if k.fields == nil {
+				k.fields = scan.Columns
+			}
+		}
+	}
+	k.fields = slices.Sorted(slices.Values(k.fields))
+	return k, body, nil
+}
+
+// readBody reads and closes the body of req.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
+
+func (k key) matches(f *Fixture) bool {
+	return k.method == f.Method && k.path == f.Path && k.symbol == f.Symbol &&
+		slices.Equal(k.fields, slices.Sorted(slices.Values(f.Fields)))
+}
+
+func (k key) String() string {
+	return fmt.Sprintf("%s %s symbol=%q with %d fields", k.method, k.path, k.symbol, len(k.fields))
+}
@@ -0,0 +1,129 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tvtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_requestKey(t *testing.T) {
+	get, _ := http.NewRequest(http.MethodGet, "https://scanner.tradingview.com/symbol?symbol=BINANCE:BTCUSDT&fields=close,RSI", nil)
+	search, _ := http.NewRequest(http.MethodGet, "https://symbol-search.tradingview.com/symbol_search/v3/?text=btc&hl=0", nil)
+	scan, _ := http.NewRequest(http.MethodPost, "https://scanner.tradingview.com/crypto/scan",
+		strings.NewReader(`{"symbols":{"tickers":["BINANCE:BTCUSDT","BINANCE:ETHUSDT"]},"columns":["close","RSI"]}`))
+
+	tests := []struct {
+		req  *http.Request
+		want key
+	}{
+		{get, key{method: "GET", path: "/symbol", symbol: "BINANCE:BTCUSDT", fields: []string{"RSI", "close"}}},
+		{search, key{method: "GET", path: "/symbol_search/v3/", symbol: "btc"}},
+		{scan, key{method: "POST", path: "/crypto/scan", symbol: "BINANCE:BTCUSDT,BINANCE:ETHUSDT", fields: []string{"RSI", "close"}}},
+	}
+	for _, tt := range tests {
+		got, _, err := requestKey(tt.req)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", tt.want.path, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("requestKey = %+v, want %+v", got, tt.want)
+		}
+	}
+
+}
+
+// closeBody records whether it was closed.
+type closeBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestRoundTripClosesBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	const scan = `{"symbols":{"tickers":["BINANCE:BTCUSDT"]},"columns":["close"]}`
+	newRequest := func() (*http.Request, *closeBody) {
+		body := &closeBody{Reader: strings.NewReader(scan)}
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/crypto/scan", body)
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(scan)), nil
+		}
+		return req, body
+	}
+
+	rec := &Recorder{Transport: server.Client().Transport}
+	req, body := newRequest()
+	resp, err := rec.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !body.closed || string(data) != scan {
+		t.Fatalf("expected the body to be sent and closed, got closed %v, echo %q", body.closed, data)
+	}
+
+	req, body = newRequest()
+	resp, err = NewReplayer(rec.Fixtures()...).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	resp.Body.Close()
+	if !body.closed {
+		t.Fatal("expected the replayer to close the body")
+	}
+
+	req, body = newRequest()
+	if _, err := NewReplayer().RoundTrip(req); err == nil || !body.closed {
+		t.Fatalf("expected an error and a closed body, got %v, closed %v", err, body.closed)
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "fixtures.json")
+	fixtures := []Fixture{
+		{Method: "GET", Path: "/symbol", Symbol: "BINANCE:BTCUSDT", Fields: []string{"close"}, Status: 200, JSON: []byte(`{"close":1}`)},
+		{Method: "GET", Path: "/symbol", Symbol: "BINANCE:NOPE", Status: 404, Body: "not found\n"},
+	}
+	if err := Save(path, fixtures); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// Saved bodies are indented for review.
+	for i := range got {
+		if got[i].JSON != nil {
+			var buf bytes.Buffer
+			_ = json.Compact(&buf, got[i].JSON)
+			got[i].JSON = buf.Bytes()
+		}
+	}
+	if !reflect.DeepEqual(got, fixtures) {
+		t.Fatalf("Load = %+v, want %+v", got, fixtures)
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
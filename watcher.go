@@ -0,0 +1,226 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// DefaultWatchInterval is how often a Watcher polls when Watcher.Every is
+// zero.
+const DefaultWatchInterval = time.Minute
+
+// EventKind identifies the kind of an Event.
+type EventKind int
+
+// Event kinds sent by a Watcher.
+const (
+	// EventUpdate carries a new snapshot for a symbol and interval.
+	EventUpdate EventKind = iota + 1
+	// EventSignalChange reports that a recommendation signal changed
+	// between two snapshots.
+	EventSignalChange
+	// EventError reports that a poll failed.
+	EventError
+)
+
+// String returns the name of k, such as "update".
+func (k EventKind) String() string {
+	switch k {
+	case EventUpdate:
+		return "update"
+	case EventSignalChange:
+		return "signal_change"
+	case EventError:
+		return "error"
+	default:
+		return fmt.Sprintf("EventKind(%d)", int(k))
+	}
+}
+
+// Event is sent by a Watcher after each poll.
+type Event struct {
+	// Kind is the kind of event.
+	Kind EventKind
+	// Symbol is the normalized symbol. It is empty for errors that affect
+	// every symbol at Interval.
+	Symbol string
+	// Interval is the polled interval.
+	Interval Interval
+	// Time is when the poll finished.
+	Time time.Time
+	// Snapshot is the new data for EventUpdate and EventSignalChange events.
+	// Each event has its own copy.
+	Snapshot *TradingView
+	// Change describes the changed signal for EventSignalChange events.
+	Change *SignalChange
+	// Err is the error for EventError events.
+	Err error
+}
+
+// SignalChange describes a recommendation signal that changed between two
+// snapshots.
+type SignalChange struct {
+	// Field is the path of the signal in Recommendations, such as
+	// "Global.Summary" or "Oscillators.RSI".
+	Field string
+	// From is the previous signal.
+	From Signal
+	// To is the new signal.
+	To Signal
+}
+
+// Watcher polls symbols at intervals and reports new snapshots and signal
+// changes as events.
+//
+// The first poll starts immediately; later polls follow Schedule, or Every
+// if Schedule is nil. Each poll fetches all Symbols with one GetMany request
+// per interval. The first snapshot of a symbol and interval is reported as
+// an EventUpdate; later snapshots are reported as an EventUpdate followed by
+// one EventSignalChange for every signal in Recommendations that changed.
+// Failures are reported as EventError events and polling continues.
+type Watcher struct {
+	// Client is used to fetch data. Nil means DefaultClient.
+	Client *Client
+	// Symbols lists the symbols to watch, as accepted by ParseSymbol.
+	Symbols []string
+	// Intervals lists the intervals to watch. Empty means daily data only.
+	Intervals []Interval
+	// Every is the time between the end of one poll and the start of the
+	// next. Zero means DefaultWatchInterval. It is ignored if Schedule is
+	// set.
+	Every time.Duration
+	// Schedule, if set, decides when to poll after the first poll, for
+	// example a BarClose. All Intervals are polled together, so watch
+	// intervals with different bar closes with separate Watchers.
+	Schedule Schedule
+	// Clock is used to wait between polls and to time events. Nil means
+	// SystemClock.
+	Clock Clock
+}
+
+// Run polls until ctx is done, calling fn for every event. Fn is called
+// from the goroutine that called Run. Run returns ctx.Err().
+func (w *Watcher) Run(ctx context.Context, fn func(Event)) error {
+	clock := clockOrSystem(w.Clock)
+	previous := make(map[watchKey]Recommendations)
+
+	schedule := w.schedule()
+	for {
+		w.poll(ctx, clock, previous, fn)
+		now := clock.Now()
+		if err := clock.Sleep(ctx, schedule.Next(now).Sub(now)); err != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// Start runs w in a new goroutine and returns a channel of its events. The
+// channel is closed after ctx is done. Polling waits while the receiver is
+// not ready for the next event.
+func (w *Watcher) Start(ctx context.Context) <-chan Event {
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		_ = w.Run(ctx, func(e Event) {
+			select {
+			case events <- e:
+			case <-ctx.Done():
+			}
+		})
+	}()
+	return events
+}
+
+// watchKey identifies a watched symbol and interval.
+type watchKey struct {
+	symbol   string
+	interval Interval
+}
+
+// poll fetches every interval once and reports the results to fn.
+func (w *Watcher) poll(ctx context.Context, clock Clock, previous map[watchKey]Recommendations, fn func(Event)) {
+	intervals := w.Intervals
+	if len(intervals) == 0 {
+		intervals = []Interval{""}
+	}
+
+	for _, interval := range intervals {
+		if ctx.Err() != nil {
+			return
+		}
+
+		results, err := w.client().GetMany(ctx, w.Symbols, interval)
+		now := clock.Now()
+
+		var batchErr *BatchError
+		if errors.As(err, &batchErr) {
+			for _, symbol := range slices.Sorted(maps.Keys(batchErr.Errors)) {
+				fn(Event{Kind: EventError, Symbol: symbol, Interval: interval, Time: now, Err: batchErr.Errors[symbol]})
+			}
+		} else if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			fn(Event{Kind: EventError, Interval: interval, Time: now, Err: err})
+			continue
+		}
+
+		for _, symbol := range slices.Sorted(maps.Keys(results)) {
+			ta := results[symbol]
+			key := watchKey{symbol: symbol, interval: interval}
+			fn(Event{Kind: EventUpdate, Symbol: symbol, Interval: interval, Time: now, Snapshot: ta.Clone()})
+
+			if from, ok := previous[key]; ok {
+				for _, change := range diffSignals(from, ta.Recommend) {
+					fn(Event{Kind: EventSignalChange, Symbol: symbol, Interval: interval, Time: now, Snapshot: ta.Clone(), Change: &change})
+				}
+			}
+			previous[key] = ta.Recommend
+		}
+	}
+}
+
+func (w *Watcher) client() *Client {
+	if w.Client == nil {
+		return &DefaultClient
+	}
+	return w.Client
+}
+
+func (w *Watcher) schedule() Schedule {
+	if w.Schedule != nil {
+		return w.Schedule
+	}
+	return Every(w.Every)
+}
+
+// diffSignals returns the signals that differ between from and to, in
+// field order.
+func diffSignals(from, to Recommendations) []SignalChange {
+	var changes []SignalChange
+	var walk func(prefix string, a, b reflect.Value)
+	walk = func(prefix string, a, b reflect.Value) {
+		for i := range a.NumField() {
+			name := prefix + a.Type().Field(i).Name
+			switch fa, fb := a.Field(i), b.Field(i); fa.Kind() {
+			case reflect.Struct:
+				walk(name+".", fa, fb)
+			default:
+				if fromSignal, toSignal := fa.Interface().(Signal), fb.Interface().(Signal); fromSignal != toSignal {
+					changes = append(changes, SignalChange{Field: name, From: fromSignal, To: toSignal})
+				}
+			}
+		}
+	}
+	walk("", reflect.ValueOf(from), reflect.ValueOf(to))
+	return changes
+}
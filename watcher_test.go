@@ -0,0 +1,224 @@
+// Copyright 2022-2026 The go-tradingview-ta Authors. All rights reserved.
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package tradingview
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// pollClock is a fake clock that cancels its context after a number of
+// sleeps.
+type pollClock struct {
+	*fakeClock
+	polls  int
+	cancel context.CancelFunc
+}
+
+func (c *pollClock) Sleep(ctx context.Context, d time.Duration) error {
+	if len(c.Sleeps())+1 >= c.polls {
+		c.cancel()
+	}
+	return c.fakeClock.Sleep(ctx, d)
+}
+
+// sequenceServer serves the scan rows in pages, one page per request, and
+// repeats the last page.
+func sequenceServer(t *testing.T, pages ...map[string]map[string]any) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		page := pages[min(calls, len(pages)-1)]
+		calls++
+		mu.Unlock()
+		scanHandler(t, page)(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWatcher_Run(t *testing.T) {
+	server := sequenceServer(t,
+		map[string]map[string]any{
+			"BINANCE:BTCUSDT": {"Recommend.All|60": 0.6, "close|60": 1},
+			"BINANCE:ETHUSDT": {"Recommend.All|60": 0, "close|60": 2},
+		},
+		map[string]map[string]any{
+			"BINANCE:BTCUSDT": {"Recommend.All|60": -0.2, "Recommend.MA|60": 0.2, "close|60": 3},
+			"BINANCE:ETHUSDT": {"Recommend.All|60": 0, "close|60": 4},
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clock := &pollClock{fakeClock: newFakeClock(), polls: 2, cancel: cancel}
+	w := &Watcher{
+		Client:    &Client{HTTPClient: server.Client(), ScanBaseURL: server.URL},
+		Symbols:   []string{"binance:ethusdt", "BINANCE:BTCUSDT"},
+		Intervals: []Interval{Interval1Hour},
+		Every:     30 * time.Second,
+		Clock:     clock,
+	}
+
+	var events []Event
+	err := w.Run(ctx, func(e Event) { events = append(events, e) })
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	type summary struct {
+		kind   EventKind
+		symbol string
+		close  float64
+		change SignalChange
+	}
+	want := []summary{
+		{kind: EventUpdate, symbol: "BINANCE:BTCUSDT", close: 1},
+		{kind: EventUpdate, symbol: "BINANCE:ETHUSDT", close: 2},
+		{kind: EventUpdate, symbol: "BINANCE:BTCUSDT", close: 3},
+		{kind: EventSignalChange, symbol: "BINANCE:BTCUSDT", close: 3, change: SignalChange{Field: "Global.Summary", From: SignalStrongBuy, To: SignalSell}},
+		{kind: EventSignalChange, symbol: "BINANCE:BTCUSDT", close: 3, change: SignalChange{Field: "Global.MA", From: SignalNeutral, To: SignalBuy}},
+		{kind: EventUpdate, symbol: "BINANCE:ETHUSDT", close: 4},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %d: %+v", len(want), len(events), events)
+	}
+	for i, e := range events {
+		got := summary{kind: e.Kind, symbol: e.Symbol, close: e.Snapshot.Value.Prices.Close}
+		if e.Change != nil {
+			got.change = *e.Change
+		}
+		if got != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, got, want[i])
+		}
+		if e.Interval != Interval1Hour {
+			t.Errorf("event %d has interval %q", i, e.Interval)
+		}
+	}
+
+	if got := clock.Sleeps(); len(got) != 1 || got[0] != 30*time.Second {
+		t.Fatalf("unexpected sleeps: %v", got)
+	}
+	if !events[2].Time.Equal(events[0].Time.Add(30 * time.Second)) {
+		t.Fatalf("unexpected event times: %v, %v", events[0].Time, events[2].Time)
+	}
+}
+
+func TestWatcher_RunErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/global/scan" {
+			scanHandler(t, map[string]map[string]any{"BINANCE:BTCUSDT": {"close": 1}})(w, r)
+			return
+		}
+		http.Error(w, "down", http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &Watcher{
+		Client:  &Client{HTTPClient: server.Client(), ScanBaseURL: server.URL},
+		Symbols: []string{"BINANCE:BTCUSDT", "BINANCE:NOPE", "invalid"},
+		Clock:   &pollClock{fakeClock: newFakeClock(), polls: 1, cancel: cancel},
+	}
+
+	var events []Event
+	_ = w.Run(ctx, func(e Event) { events = append(events, e) })
+
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %+v", events)
+	}
+	if e := events[0]; e.Kind != EventError || e.Symbol != "BINANCE:NOPE" || !errors.Is(e.Err, ErrSymbolNotFound) {
+		t.Errorf("unexpected event %+v", e)
+	}
+	if e := events[1]; e.Kind != EventError || e.Symbol != "invalid" || !errors.Is(e.Err, ErrInvalidSymbol) {
+		t.Errorf("unexpected event %+v", e)
+	}
+	if e := events[2]; e.Kind != EventUpdate || e.Symbol != "BINANCE:BTCUSDT" {
+		t.Errorf("unexpected event %+v", e)
+	}
+
+	w.Client.ScanBaseURL = server.URL + "/broken"
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	w.Clock = &pollClock{fakeClock: newFakeClock(), polls: 1, cancel: cancel}
+	events = nil
+	_ = w.Run(ctx, func(e Event) { events = append(events, e) })
+
+	if len(events) != 1 || events[0].Kind != EventError || events[0].Symbol != "" || !errors.Is(events[0].Err, ErrServerError) {
+		t.Fatalf("expected one request error event, got %+v", events)
+	}
+}
+
+func TestWatcher_Start(t *testing.T) {
+	server := sequenceServer(t, map[string]map[string]any{
+		"BINANCE:BTCUSDT": {"close": 1, "close|1": 2},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := &Watcher{
+		Client:    &Client{HTTPClient: server.Client(), ScanBaseURL: server.URL},
+		Symbols:   []string{"BINANCE:BTCUSDT"},
+		Intervals: []Interval{Interval1Min, Interval1Day},
+		Clock:     newFakeClock(),
+	}
+
+	events := w.Start(ctx)
+	first, second := <-events, <-events
+	if first.Interval != Interval1Min || first.Snapshot.Value.Prices.Close != 2 {
+		t.Fatalf("unexpected first event %+v", first)
+	}
+	if second.Interval != Interval1Day || second.Snapshot.Value.Prices.Close != 1 {
+		t.Fatalf("unexpected second event %+v", second)
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func Test_diffSignals(t *testing.T) {
+	var from, to Recommendations
+	if changes := diffSignals(from, to); len(changes) != 0 {
+		t.Fatalf("expected no changes, got %+v", changes)
+	}
+
+	to.Oscillators.RSI = SignalBuy
+	to.MovingAverages.HullMA = SignalSell
+	want := []SignalChange{
+		{Field: "Oscillators.RSI", From: SignalNeutral, To: SignalBuy},
+		{Field: "MovingAverages.HullMA", From: SignalNeutral, To: SignalSell},
+	}
+	changes := diffSignals(from, to)
+	if len(changes) != len(want) {
+		t.Fatalf("diffSignals() = %+v, want %+v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("diffSignals()[%d] = %+v, want %+v", i, changes[i], want[i])
+		}
+	}
+}
+
+func TestEventKind_String(t *testing.T) {
+	for kind, want := range map[EventKind]string{
+		EventUpdate:       "update",
+		EventSignalChange: "signal_change",
+		EventError:        "error",
+		EventKind(9):      "EventKind(9)",
+	} {
+		if got := kind.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(kind), got, want)
+		}
+	}
+}